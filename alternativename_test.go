@@ -36,7 +36,7 @@ func TestAlternativeNameService_Get(t *testing.T) {
 	}{
 		{"Valid response", testAlternativeNameGet, 8989, []Option{SetFields("name")}, init[0], nil},
 		{"Invalid ID", testFileEmpty, -1, nil, nil, ErrNegativeID},
-		{"Empty response", testFileEmpty, 8989, nil, nil, errInvalidJSON},
+		{"Empty response", testFileEmpty, 8989, nil, nil, ErrInvalidJSON},
 		{"Invalid option", testFileEmpty, 8989, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
 		{"No results", testFileEmptyArray, 0, nil, nil, ErrNoResults},
 	}
@@ -83,7 +83,7 @@ func TestAlternativeNameService_List(t *testing.T) {
 		{"Valid response", testAlternativeNameList, []int{10758, 3254, 9036, 9008, 4626, 13861, 13874, 13862}, []Option{SetLimit(5)}, init, nil},
 		{"Zero IDs", testFileEmpty, nil, nil, nil, ErrEmptyIDs},
 		{"Invalid ID", testFileEmpty, []int{-500}, nil, nil, ErrNegativeID},
-		{"Empty response", testFileEmpty, []int{10758, 3254, 9036, 9008, 4626, 13861, 13874, 13862}, nil, nil, errInvalidJSON},
+		{"Empty response", testFileEmpty, []int{10758, 3254, 9036, 9008, 4626, 13861, 13874, 13862}, nil, nil, ErrInvalidJSON},
 		{"Invalid option", testFileEmpty, []int{10758, 3254, 9036, 9008, 4626, 13861, 13874, 13862}, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
 		{"No results", testFileEmptyArray, []int{0, 9999999}, nil, nil, ErrNoResults},
 	}
@@ -127,7 +127,7 @@ func TestAlternativeNameService_Index(t *testing.T) {
 		wantErr              error
 	}{
 		{"Valid response", testAlternativeNameList, []Option{SetLimit(5)}, init, nil},
-		{"Empty response", testFileEmpty, nil, nil, errInvalidJSON},
+		{"Empty response", testFileEmpty, nil, nil, ErrInvalidJSON},
 		{"Invalid option", testFileEmpty, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
 		{"No results", testFileEmptyArray, nil, nil, ErrNoResults},
 	}
@@ -160,7 +160,7 @@ func TestAlternativeNameService_Count(t *testing.T) {
 		wantErr   error
 	}{
 		{"Happy path", `{"count": 100}`, []Option{SetFilter("popularity", OpGreaterThan, "75")}, 100, nil},
-		{"Empty response", "", nil, 0, errInvalidJSON},
+		{"Empty response", "", nil, 0, ErrInvalidJSON},
 		{"Invalid option", "", []Option{SetLimit(-100)}, 0, ErrOutOfRange},
 		{"No results", "[]", nil, 0, ErrNoResults},
 	}
@@ -191,7 +191,7 @@ func TestAlternativeNameService_Fields(t *testing.T) {
 	}{
 		{"Happy path", `["name", "slug", "url"]`, []string{"url", "slug", "name"}, nil},
 		{"Asterisk", `["*"]`, []string{"*"}, nil},
-		{"Empty response", "", nil, errInvalidJSON},
+		{"Empty response", "", nil, ErrInvalidJSON},
 		{"No results", "[]", nil, nil},
 	}
 
@@ -0,0 +1,1549 @@
+// Code generated by "go run ./internal/apigen"; DO NOT EDIT.
+
+package igdb
+
+import "context"
+
+// API is the interface implemented by Client. Depend on API instead of *Client
+// to substitute a mock, such as igdbmock.Client, in tests. Each service of the
+// Client is returned as its interface by the method named after its field.
+//
+// The interfaces only cover the basic operations of the services, those that
+// make a single request. Iterate, IterateSearch, Scan, Loader, GetDetails, and
+// Calendar are only available on the services themselves.
+type API interface {
+	AchievementsAPI() AchievementAPI
+	AchievementIconsAPI() AchievementIconAPI
+	AgeRatingsAPI() AgeRatingAPI
+	AgeRatingContentsAPI() AgeRatingContentAPI
+	AlternativeNamesAPI() AlternativeNameAPI
+	ArtworksAPI() ArtworkAPI
+	CharactersAPI() CharacterAPI
+	CharacterMugshotsAPI() CharacterMugshotAPI
+	CollectionsAPI() CollectionAPI
+	CompaniesAPI() CompanyAPI
+	CompanyLogosAPI() CompanyLogoAPI
+	CompanyWebsitesAPI() CompanyWebsiteAPI
+	CoversAPI() CoverAPI
+	ExternalGamesAPI() ExternalGameAPI
+	FeedsAPI() FeedAPI
+	FranchisesAPI() FranchiseAPI
+	GamesAPI() GameAPI
+	GameEnginesAPI() GameEngineAPI
+	GameEngineLogosAPI() GameEngineLogoAPI
+	GameModesAPI() GameModeAPI
+	GameVersionsAPI() GameVersionAPI
+	GameVersionFeaturesAPI() GameVersionFeatureAPI
+	GameVersionFeatureValuesAPI() GameVersionFeatureValueAPI
+	GameVideosAPI() GameVideoAPI
+	GenresAPI() GenreAPI
+	InvolvedCompaniesAPI() InvolvedCompanyAPI
+	KeywordsAPI() KeywordAPI
+	MultiplayerModesAPI() MultiplayerModeAPI
+	PagesAPI() PageAPI
+	PageBackgroundsAPI() PageBackgroundAPI
+	PageLogosAPI() PageLogoAPI
+	PageWebsitesAPI() PageWebsiteAPI
+	PlatformsAPI() PlatformAPI
+	PlatformLogosAPI() PlatformLogoAPI
+	PlatformVersionsAPI() PlatformVersionAPI
+	PlatformVersionCompaniesAPI() PlatformVersionCompanyAPI
+	PlatformVersionReleaseDatesAPI() PlatformVersionReleaseDateAPI
+	PlatformWebsitesAPI() PlatformWebsiteAPI
+	PlayerPerspectivesAPI() PlayerPerspectiveAPI
+	ProductFamiliesAPI() ProductFamilyAPI
+	PulsesAPI() PulseAPI
+	PulseGroupsAPI() PulseGroupAPI
+	PulseSourcesAPI() PulseSourceAPI
+	PulseURLsAPI() PulseURLAPI
+	ReleaseDatesAPI() ReleaseDateAPI
+	ScreenshotsAPI() ScreenshotAPI
+	ThemesAPI() ThemeAPI
+	TimeToBeatsAPI() TimeToBeatAPI
+	TitlesAPI() TitleAPI
+	WebsitesAPI() WebsiteAPI
+	CreditsAPI() CreditAPI
+	FeedFollowsAPI() FeedFollowAPI
+	FollowsAPI() FollowAPI
+	ListsAPI() ListAPI
+	ListEntrysAPI() ListEntryAPI
+	PersonsAPI() PersonAPI
+	PersonMugshotsAPI() PersonMugshotAPI
+	PersonWebsitesAPI() PersonWebsiteAPI
+	RatesAPI() RateAPI
+	ReviewsAPI() ReviewAPI
+	ReviewVideosAPI() ReviewVideoAPI
+	SocialMetricsAPI() SocialMetricAPI
+	TestDummiesAPI() TestDummyAPI
+
+	Search(qry string, opts ...Option) ([]*SearchResult, error)
+	SearchContext(ctx context.Context, qry string, opts ...Option) ([]*SearchResult, error)
+	Status() (*Status, error)
+	StatusContext(ctx context.Context) (*Status, error)
+	ResolveTags(ctx context.Context, tags []Tag, opts ...Option) (*TaggedObjects, error)
+}
+
+var _ API = (*Client)(nil)
+
+// AchievementsAPI returns the Achievements service of the Client as the AchievementAPI interface.
+func (c *Client) AchievementsAPI() AchievementAPI {
+	return c.Achievements
+}
+
+// AchievementIconsAPI returns the AchievementIcons service of the Client as the AchievementIconAPI interface.
+func (c *Client) AchievementIconsAPI() AchievementIconAPI {
+	return c.AchievementIcons
+}
+
+// AgeRatingsAPI returns the AgeRatings service of the Client as the AgeRatingAPI interface.
+func (c *Client) AgeRatingsAPI() AgeRatingAPI {
+	return c.AgeRatings
+}
+
+// AgeRatingContentsAPI returns the AgeRatingContents service of the Client as the AgeRatingContentAPI interface.
+func (c *Client) AgeRatingContentsAPI() AgeRatingContentAPI {
+	return c.AgeRatingContents
+}
+
+// AlternativeNamesAPI returns the AlternativeNames service of the Client as the AlternativeNameAPI interface.
+func (c *Client) AlternativeNamesAPI() AlternativeNameAPI {
+	return c.AlternativeNames
+}
+
+// ArtworksAPI returns the Artworks service of the Client as the ArtworkAPI interface.
+func (c *Client) ArtworksAPI() ArtworkAPI {
+	return c.Artworks
+}
+
+// CharactersAPI returns the Characters service of the Client as the CharacterAPI interface.
+func (c *Client) CharactersAPI() CharacterAPI {
+	return c.Characters
+}
+
+// CharacterMugshotsAPI returns the CharacterMugshots service of the Client as the CharacterMugshotAPI interface.
+func (c *Client) CharacterMugshotsAPI() CharacterMugshotAPI {
+	return c.CharacterMugshots
+}
+
+// CollectionsAPI returns the Collections service of the Client as the CollectionAPI interface.
+func (c *Client) CollectionsAPI() CollectionAPI {
+	return c.Collections
+}
+
+// CompaniesAPI returns the Companies service of the Client as the CompanyAPI interface.
+func (c *Client) CompaniesAPI() CompanyAPI {
+	return c.Companies
+}
+
+// CompanyLogosAPI returns the CompanyLogos service of the Client as the CompanyLogoAPI interface.
+func (c *Client) CompanyLogosAPI() CompanyLogoAPI {
+	return c.CompanyLogos
+}
+
+// CompanyWebsitesAPI returns the CompanyWebsites service of the Client as the CompanyWebsiteAPI interface.
+func (c *Client) CompanyWebsitesAPI() CompanyWebsiteAPI {
+	return c.CompanyWebsites
+}
+
+// CoversAPI returns the Covers service of the Client as the CoverAPI interface.
+func (c *Client) CoversAPI() CoverAPI {
+	return c.Covers
+}
+
+// ExternalGamesAPI returns the ExternalGames service of the Client as the ExternalGameAPI interface.
+func (c *Client) ExternalGamesAPI() ExternalGameAPI {
+	return c.ExternalGames
+}
+
+// FeedsAPI returns the Feeds service of the Client as the FeedAPI interface.
+func (c *Client) FeedsAPI() FeedAPI {
+	return c.Feeds
+}
+
+// FranchisesAPI returns the Franchises service of the Client as the FranchiseAPI interface.
+func (c *Client) FranchisesAPI() FranchiseAPI {
+	return c.Franchises
+}
+
+// GamesAPI returns the Games service of the Client as the GameAPI interface.
+func (c *Client) GamesAPI() GameAPI {
+	return c.Games
+}
+
+// GameEnginesAPI returns the GameEngines service of the Client as the GameEngineAPI interface.
+func (c *Client) GameEnginesAPI() GameEngineAPI {
+	return c.GameEngines
+}
+
+// GameEngineLogosAPI returns the GameEngineLogos service of the Client as the GameEngineLogoAPI interface.
+func (c *Client) GameEngineLogosAPI() GameEngineLogoAPI {
+	return c.GameEngineLogos
+}
+
+// GameModesAPI returns the GameModes service of the Client as the GameModeAPI interface.
+func (c *Client) GameModesAPI() GameModeAPI {
+	return c.GameModes
+}
+
+// GameVersionsAPI returns the GameVersions service of the Client as the GameVersionAPI interface.
+func (c *Client) GameVersionsAPI() GameVersionAPI {
+	return c.GameVersions
+}
+
+// GameVersionFeaturesAPI returns the GameVersionFeatures service of the Client as the GameVersionFeatureAPI interface.
+func (c *Client) GameVersionFeaturesAPI() GameVersionFeatureAPI {
+	return c.GameVersionFeatures
+}
+
+// GameVersionFeatureValuesAPI returns the GameVersionFeatureValues service of the Client as the GameVersionFeatureValueAPI interface.
+func (c *Client) GameVersionFeatureValuesAPI() GameVersionFeatureValueAPI {
+	return c.GameVersionFeatureValues
+}
+
+// GameVideosAPI returns the GameVideos service of the Client as the GameVideoAPI interface.
+func (c *Client) GameVideosAPI() GameVideoAPI {
+	return c.GameVideos
+}
+
+// GenresAPI returns the Genres service of the Client as the GenreAPI interface.
+func (c *Client) GenresAPI() GenreAPI {
+	return c.Genres
+}
+
+// InvolvedCompaniesAPI returns the InvolvedCompanies service of the Client as the InvolvedCompanyAPI interface.
+func (c *Client) InvolvedCompaniesAPI() InvolvedCompanyAPI {
+	return c.InvolvedCompanies
+}
+
+// KeywordsAPI returns the Keywords service of the Client as the KeywordAPI interface.
+func (c *Client) KeywordsAPI() KeywordAPI {
+	return c.Keywords
+}
+
+// MultiplayerModesAPI returns the MultiplayerModes service of the Client as the MultiplayerModeAPI interface.
+func (c *Client) MultiplayerModesAPI() MultiplayerModeAPI {
+	return c.MultiplayerModes
+}
+
+// PagesAPI returns the Pages service of the Client as the PageAPI interface.
+func (c *Client) PagesAPI() PageAPI {
+	return c.Pages
+}
+
+// PageBackgroundsAPI returns the PageBackgrounds service of the Client as the PageBackgroundAPI interface.
+func (c *Client) PageBackgroundsAPI() PageBackgroundAPI {
+	return c.PageBackgrounds
+}
+
+// PageLogosAPI returns the PageLogos service of the Client as the PageLogoAPI interface.
+func (c *Client) PageLogosAPI() PageLogoAPI {
+	return c.PageLogos
+}
+
+// PageWebsitesAPI returns the PageWebsites service of the Client as the PageWebsiteAPI interface.
+func (c *Client) PageWebsitesAPI() PageWebsiteAPI {
+	return c.PageWebsites
+}
+
+// PlatformsAPI returns the Platforms service of the Client as the PlatformAPI interface.
+func (c *Client) PlatformsAPI() PlatformAPI {
+	return c.Platforms
+}
+
+// PlatformLogosAPI returns the PlatformLogos service of the Client as the PlatformLogoAPI interface.
+func (c *Client) PlatformLogosAPI() PlatformLogoAPI {
+	return c.PlatformLogos
+}
+
+// PlatformVersionsAPI returns the PlatformVersions service of the Client as the PlatformVersionAPI interface.
+func (c *Client) PlatformVersionsAPI() PlatformVersionAPI {
+	return c.PlatformVersions
+}
+
+// PlatformVersionCompaniesAPI returns the PlatformVersionCompanies service of the Client as the PlatformVersionCompanyAPI interface.
+func (c *Client) PlatformVersionCompaniesAPI() PlatformVersionCompanyAPI {
+	return c.PlatformVersionCompanies
+}
+
+// PlatformVersionReleaseDatesAPI returns the PlatformVersionReleaseDates service of the Client as the PlatformVersionReleaseDateAPI interface.
+func (c *Client) PlatformVersionReleaseDatesAPI() PlatformVersionReleaseDateAPI {
+	return c.PlatformVersionReleaseDates
+}
+
+// PlatformWebsitesAPI returns the PlatformWebsites service of the Client as the PlatformWebsiteAPI interface.
+func (c *Client) PlatformWebsitesAPI() PlatformWebsiteAPI {
+	return c.PlatformWebsites
+}
+
+// PlayerPerspectivesAPI returns the PlayerPerspectives service of the Client as the PlayerPerspectiveAPI interface.
+func (c *Client) PlayerPerspectivesAPI() PlayerPerspectiveAPI {
+	return c.PlayerPerspectives
+}
+
+// ProductFamiliesAPI returns the ProductFamilies service of the Client as the ProductFamilyAPI interface.
+func (c *Client) ProductFamiliesAPI() ProductFamilyAPI {
+	return c.ProductFamilies
+}
+
+// PulsesAPI returns the Pulses service of the Client as the PulseAPI interface.
+func (c *Client) PulsesAPI() PulseAPI {
+	return c.Pulses
+}
+
+// PulseGroupsAPI returns the PulseGroups service of the Client as the PulseGroupAPI interface.
+func (c *Client) PulseGroupsAPI() PulseGroupAPI {
+	return c.PulseGroups
+}
+
+// PulseSourcesAPI returns the PulseSources service of the Client as the PulseSourceAPI interface.
+func (c *Client) PulseSourcesAPI() PulseSourceAPI {
+	return c.PulseSources
+}
+
+// PulseURLsAPI returns the PulseURLs service of the Client as the PulseURLAPI interface.
+func (c *Client) PulseURLsAPI() PulseURLAPI {
+	return c.PulseURLs
+}
+
+// ReleaseDatesAPI returns the ReleaseDates service of the Client as the ReleaseDateAPI interface.
+func (c *Client) ReleaseDatesAPI() ReleaseDateAPI {
+	return c.ReleaseDates
+}
+
+// ScreenshotsAPI returns the Screenshots service of the Client as the ScreenshotAPI interface.
+func (c *Client) ScreenshotsAPI() ScreenshotAPI {
+	return c.Screenshots
+}
+
+// ThemesAPI returns the Themes service of the Client as the ThemeAPI interface.
+func (c *Client) ThemesAPI() ThemeAPI {
+	return c.Themes
+}
+
+// TimeToBeatsAPI returns the TimeToBeats service of the Client as the TimeToBeatAPI interface.
+func (c *Client) TimeToBeatsAPI() TimeToBeatAPI {
+	return c.TimeToBeats
+}
+
+// TitlesAPI returns the Titles service of the Client as the TitleAPI interface.
+func (c *Client) TitlesAPI() TitleAPI {
+	return c.Titles
+}
+
+// WebsitesAPI returns the Websites service of the Client as the WebsiteAPI interface.
+func (c *Client) WebsitesAPI() WebsiteAPI {
+	return c.Websites
+}
+
+// CreditsAPI returns the Credits service of the Client as the CreditAPI interface.
+func (c *Client) CreditsAPI() CreditAPI {
+	return c.Credits
+}
+
+// FeedFollowsAPI returns the FeedFollows service of the Client as the FeedFollowAPI interface.
+func (c *Client) FeedFollowsAPI() FeedFollowAPI {
+	return c.FeedFollows
+}
+
+// FollowsAPI returns the Follows service of the Client as the FollowAPI interface.
+func (c *Client) FollowsAPI() FollowAPI {
+	return c.Follows
+}
+
+// ListsAPI returns the Lists service of the Client as the ListAPI interface.
+func (c *Client) ListsAPI() ListAPI {
+	return c.Lists
+}
+
+// ListEntrysAPI returns the ListEntrys service of the Client as the ListEntryAPI interface.
+func (c *Client) ListEntrysAPI() ListEntryAPI {
+	return c.ListEntrys
+}
+
+// PersonsAPI returns the Persons service of the Client as the PersonAPI interface.
+func (c *Client) PersonsAPI() PersonAPI {
+	return c.Persons
+}
+
+// PersonMugshotsAPI returns the PersonMugshots service of the Client as the PersonMugshotAPI interface.
+func (c *Client) PersonMugshotsAPI() PersonMugshotAPI {
+	return c.PersonMugshots
+}
+
+// PersonWebsitesAPI returns the PersonWebsites service of the Client as the PersonWebsiteAPI interface.
+func (c *Client) PersonWebsitesAPI() PersonWebsiteAPI {
+	return c.PersonWebsites
+}
+
+// RatesAPI returns the Rates service of the Client as the RateAPI interface.
+func (c *Client) RatesAPI() RateAPI {
+	return c.Rates
+}
+
+// ReviewsAPI returns the Reviews service of the Client as the ReviewAPI interface.
+func (c *Client) ReviewsAPI() ReviewAPI {
+	return c.Reviews
+}
+
+// ReviewVideosAPI returns the ReviewVideos service of the Client as the ReviewVideoAPI interface.
+func (c *Client) ReviewVideosAPI() ReviewVideoAPI {
+	return c.ReviewVideos
+}
+
+// SocialMetricsAPI returns the SocialMetrics service of the Client as the SocialMetricAPI interface.
+func (c *Client) SocialMetricsAPI() SocialMetricAPI {
+	return c.SocialMetrics
+}
+
+// TestDummiesAPI returns the TestDummies service of the Client as the TestDummyAPI interface.
+func (c *Client) TestDummiesAPI() TestDummyAPI {
+	return c.TestDummies
+}
+
+// AchievementAPI is the interface implemented by AchievementService. Depend on AchievementAPI
+// instead of *AchievementService to substitute a mock, such as igdbmock.AchievementAPI, in tests.
+// It only covers the basic operations of the service.
+type AchievementAPI interface {
+	Get(id int, opts ...Option) (*Achievement, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*Achievement, error)
+	List(ids []int, opts ...Option) ([]*Achievement, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*Achievement, error)
+	Index(opts ...Option) ([]*Achievement, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*Achievement, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ AchievementAPI = (*AchievementService)(nil)
+
+// AchievementIconAPI is the interface implemented by AchievementIconService. Depend on AchievementIconAPI
+// instead of *AchievementIconService to substitute a mock, such as igdbmock.AchievementIconAPI, in tests.
+// It only covers the basic operations of the service.
+type AchievementIconAPI interface {
+	Get(id int, opts ...Option) (*AchievementIcon, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*AchievementIcon, error)
+	List(ids []int, opts ...Option) ([]*AchievementIcon, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*AchievementIcon, error)
+	Index(opts ...Option) ([]*AchievementIcon, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*AchievementIcon, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ AchievementIconAPI = (*AchievementIconService)(nil)
+
+// AgeRatingAPI is the interface implemented by AgeRatingService. Depend on AgeRatingAPI
+// instead of *AgeRatingService to substitute a mock, such as igdbmock.AgeRatingAPI, in tests.
+// It only covers the basic operations of the service.
+type AgeRatingAPI interface {
+	Get(id int, opts ...Option) (*AgeRating, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*AgeRating, error)
+	List(ids []int, opts ...Option) ([]*AgeRating, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*AgeRating, error)
+	Index(opts ...Option) ([]*AgeRating, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*AgeRating, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ AgeRatingAPI = (*AgeRatingService)(nil)
+
+// AgeRatingContentAPI is the interface implemented by AgeRatingContentService. Depend on AgeRatingContentAPI
+// instead of *AgeRatingContentService to substitute a mock, such as igdbmock.AgeRatingContentAPI, in tests.
+// It only covers the basic operations of the service.
+type AgeRatingContentAPI interface {
+	Get(id int, opts ...Option) (*AgeRatingContent, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*AgeRatingContent, error)
+	List(ids []int, opts ...Option) ([]*AgeRatingContent, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*AgeRatingContent, error)
+	Index(opts ...Option) ([]*AgeRatingContent, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*AgeRatingContent, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ AgeRatingContentAPI = (*AgeRatingContentService)(nil)
+
+// AlternativeNameAPI is the interface implemented by AlternativeNameService. Depend on AlternativeNameAPI
+// instead of *AlternativeNameService to substitute a mock, such as igdbmock.AlternativeNameAPI, in tests.
+// It only covers the basic operations of the service.
+type AlternativeNameAPI interface {
+	Get(id int, opts ...Option) (*AlternativeName, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*AlternativeName, error)
+	List(ids []int, opts ...Option) ([]*AlternativeName, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*AlternativeName, error)
+	Index(opts ...Option) ([]*AlternativeName, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*AlternativeName, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ AlternativeNameAPI = (*AlternativeNameService)(nil)
+
+// ArtworkAPI is the interface implemented by ArtworkService. Depend on ArtworkAPI
+// instead of *ArtworkService to substitute a mock, such as igdbmock.ArtworkAPI, in tests.
+// It only covers the basic operations of the service.
+type ArtworkAPI interface {
+	Get(id int, opts ...Option) (*Artwork, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*Artwork, error)
+	List(ids []int, opts ...Option) ([]*Artwork, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*Artwork, error)
+	Index(opts ...Option) ([]*Artwork, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*Artwork, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ ArtworkAPI = (*ArtworkService)(nil)
+
+// CharacterAPI is the interface implemented by CharacterService. Depend on CharacterAPI
+// instead of *CharacterService to substitute a mock, such as igdbmock.CharacterAPI, in tests.
+// It only covers the basic operations of the service.
+type CharacterAPI interface {
+	Get(id int, opts ...Option) (*Character, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*Character, error)
+	List(ids []int, opts ...Option) ([]*Character, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*Character, error)
+	Index(opts ...Option) ([]*Character, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*Character, error)
+	Search(qry string, opts ...Option) ([]*Character, error)
+	SearchContext(ctx context.Context, qry string, opts ...Option) ([]*Character, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ CharacterAPI = (*CharacterService)(nil)
+
+// CharacterMugshotAPI is the interface implemented by CharacterMugshotService. Depend on CharacterMugshotAPI
+// instead of *CharacterMugshotService to substitute a mock, such as igdbmock.CharacterMugshotAPI, in tests.
+// It only covers the basic operations of the service.
+type CharacterMugshotAPI interface {
+	Get(id int, opts ...Option) (*CharacterMugshot, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*CharacterMugshot, error)
+	List(ids []int, opts ...Option) ([]*CharacterMugshot, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*CharacterMugshot, error)
+	Index(opts ...Option) ([]*CharacterMugshot, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*CharacterMugshot, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ CharacterMugshotAPI = (*CharacterMugshotService)(nil)
+
+// CollectionAPI is the interface implemented by CollectionService. Depend on CollectionAPI
+// instead of *CollectionService to substitute a mock, such as igdbmock.CollectionAPI, in tests.
+// It only covers the basic operations of the service.
+type CollectionAPI interface {
+	Get(id int, opts ...Option) (*Collection, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*Collection, error)
+	List(ids []int, opts ...Option) ([]*Collection, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*Collection, error)
+	Index(opts ...Option) ([]*Collection, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*Collection, error)
+	Search(qry string, opts ...Option) ([]*Collection, error)
+	SearchContext(ctx context.Context, qry string, opts ...Option) ([]*Collection, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ CollectionAPI = (*CollectionService)(nil)
+
+// CompanyAPI is the interface implemented by CompanyService. Depend on CompanyAPI
+// instead of *CompanyService to substitute a mock, such as igdbmock.CompanyAPI, in tests.
+// It only covers the basic operations of the service.
+type CompanyAPI interface {
+	Get(id int, opts ...Option) (*Company, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*Company, error)
+	List(ids []int, opts ...Option) ([]*Company, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*Company, error)
+	Index(opts ...Option) ([]*Company, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*Company, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ CompanyAPI = (*CompanyService)(nil)
+
+// CompanyLogoAPI is the interface implemented by CompanyLogoService. Depend on CompanyLogoAPI
+// instead of *CompanyLogoService to substitute a mock, such as igdbmock.CompanyLogoAPI, in tests.
+// It only covers the basic operations of the service.
+type CompanyLogoAPI interface {
+	Get(id int, opts ...Option) (*CompanyLogo, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*CompanyLogo, error)
+	List(ids []int, opts ...Option) ([]*CompanyLogo, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*CompanyLogo, error)
+	Index(opts ...Option) ([]*CompanyLogo, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*CompanyLogo, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ CompanyLogoAPI = (*CompanyLogoService)(nil)
+
+// CompanyWebsiteAPI is the interface implemented by CompanyWebsiteService. Depend on CompanyWebsiteAPI
+// instead of *CompanyWebsiteService to substitute a mock, such as igdbmock.CompanyWebsiteAPI, in tests.
+// It only covers the basic operations of the service.
+type CompanyWebsiteAPI interface {
+	Get(id int, opts ...Option) (*CompanyWebsite, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*CompanyWebsite, error)
+	List(ids []int, opts ...Option) ([]*CompanyWebsite, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*CompanyWebsite, error)
+	Index(opts ...Option) ([]*CompanyWebsite, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*CompanyWebsite, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ CompanyWebsiteAPI = (*CompanyWebsiteService)(nil)
+
+// CoverAPI is the interface implemented by CoverService. Depend on CoverAPI
+// instead of *CoverService to substitute a mock, such as igdbmock.CoverAPI, in tests.
+// It only covers the basic operations of the service.
+type CoverAPI interface {
+	Get(id int, opts ...Option) (*Cover, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*Cover, error)
+	List(ids []int, opts ...Option) ([]*Cover, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*Cover, error)
+	Index(opts ...Option) ([]*Cover, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*Cover, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ CoverAPI = (*CoverService)(nil)
+
+// ExternalGameAPI is the interface implemented by ExternalGameService. Depend on ExternalGameAPI
+// instead of *ExternalGameService to substitute a mock, such as igdbmock.ExternalGameAPI, in tests.
+// It only covers the basic operations of the service.
+type ExternalGameAPI interface {
+	Get(id int, opts ...Option) (*ExternalGame, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*ExternalGame, error)
+	List(ids []int, opts ...Option) ([]*ExternalGame, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*ExternalGame, error)
+	Index(opts ...Option) ([]*ExternalGame, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*ExternalGame, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ ExternalGameAPI = (*ExternalGameService)(nil)
+
+// FeedAPI is the interface implemented by FeedService. Depend on FeedAPI
+// instead of *FeedService to substitute a mock, such as igdbmock.FeedAPI, in tests.
+// It only covers the basic operations of the service.
+type FeedAPI interface {
+	Get(id int, opts ...Option) (*Feed, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*Feed, error)
+	List(ids []int, opts ...Option) ([]*Feed, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*Feed, error)
+	Index(opts ...Option) ([]*Feed, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*Feed, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ FeedAPI = (*FeedService)(nil)
+
+// FranchiseAPI is the interface implemented by FranchiseService. Depend on FranchiseAPI
+// instead of *FranchiseService to substitute a mock, such as igdbmock.FranchiseAPI, in tests.
+// It only covers the basic operations of the service.
+type FranchiseAPI interface {
+	Get(id int, opts ...Option) (*Franchise, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*Franchise, error)
+	List(ids []int, opts ...Option) ([]*Franchise, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*Franchise, error)
+	Index(opts ...Option) ([]*Franchise, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*Franchise, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ FranchiseAPI = (*FranchiseService)(nil)
+
+// GameAPI is the interface implemented by GameService. Depend on GameAPI
+// instead of *GameService to substitute a mock, such as igdbmock.GameAPI, in tests.
+// It only covers the basic operations of the service.
+type GameAPI interface {
+	Get(id int, opts ...Option) (*Game, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*Game, error)
+	List(ids []int, opts ...Option) ([]*Game, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*Game, error)
+	Index(opts ...Option) ([]*Game, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*Game, error)
+	Search(qry string, opts ...Option) ([]*Game, error)
+	SearchContext(ctx context.Context, qry string, opts ...Option) ([]*Game, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ GameAPI = (*GameService)(nil)
+
+// GameEngineAPI is the interface implemented by GameEngineService. Depend on GameEngineAPI
+// instead of *GameEngineService to substitute a mock, such as igdbmock.GameEngineAPI, in tests.
+// It only covers the basic operations of the service.
+type GameEngineAPI interface {
+	Get(id int, opts ...Option) (*GameEngine, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*GameEngine, error)
+	List(ids []int, opts ...Option) ([]*GameEngine, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*GameEngine, error)
+	Index(opts ...Option) ([]*GameEngine, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*GameEngine, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ GameEngineAPI = (*GameEngineService)(nil)
+
+// GameEngineLogoAPI is the interface implemented by GameEngineLogoService. Depend on GameEngineLogoAPI
+// instead of *GameEngineLogoService to substitute a mock, such as igdbmock.GameEngineLogoAPI, in tests.
+// It only covers the basic operations of the service.
+type GameEngineLogoAPI interface {
+	Get(id int, opts ...Option) (*GameEngineLogo, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*GameEngineLogo, error)
+	List(ids []int, opts ...Option) ([]*GameEngineLogo, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*GameEngineLogo, error)
+	Index(opts ...Option) ([]*GameEngineLogo, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*GameEngineLogo, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ GameEngineLogoAPI = (*GameEngineLogoService)(nil)
+
+// GameModeAPI is the interface implemented by GameModeService. Depend on GameModeAPI
+// instead of *GameModeService to substitute a mock, such as igdbmock.GameModeAPI, in tests.
+// It only covers the basic operations of the service.
+type GameModeAPI interface {
+	Get(id int, opts ...Option) (*GameMode, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*GameMode, error)
+	List(ids []int, opts ...Option) ([]*GameMode, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*GameMode, error)
+	Index(opts ...Option) ([]*GameMode, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*GameMode, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ GameModeAPI = (*GameModeService)(nil)
+
+// GameVersionAPI is the interface implemented by GameVersionService. Depend on GameVersionAPI
+// instead of *GameVersionService to substitute a mock, such as igdbmock.GameVersionAPI, in tests.
+// It only covers the basic operations of the service.
+type GameVersionAPI interface {
+	Get(id int, opts ...Option) (*GameVersion, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*GameVersion, error)
+	List(ids []int, opts ...Option) ([]*GameVersion, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*GameVersion, error)
+	Index(opts ...Option) ([]*GameVersion, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*GameVersion, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ GameVersionAPI = (*GameVersionService)(nil)
+
+// GameVersionFeatureAPI is the interface implemented by GameVersionFeatureService. Depend on GameVersionFeatureAPI
+// instead of *GameVersionFeatureService to substitute a mock, such as igdbmock.GameVersionFeatureAPI, in tests.
+// It only covers the basic operations of the service.
+type GameVersionFeatureAPI interface {
+	Get(id int, opts ...Option) (*GameVersionFeature, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*GameVersionFeature, error)
+	List(ids []int, opts ...Option) ([]*GameVersionFeature, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*GameVersionFeature, error)
+	Index(opts ...Option) ([]*GameVersionFeature, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*GameVersionFeature, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ GameVersionFeatureAPI = (*GameVersionFeatureService)(nil)
+
+// GameVersionFeatureValueAPI is the interface implemented by GameVersionFeatureValueService. Depend on GameVersionFeatureValueAPI
+// instead of *GameVersionFeatureValueService to substitute a mock, such as igdbmock.GameVersionFeatureValueAPI, in tests.
+// It only covers the basic operations of the service.
+type GameVersionFeatureValueAPI interface {
+	Get(id int, opts ...Option) (*GameVersionFeatureValue, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*GameVersionFeatureValue, error)
+	List(ids []int, opts ...Option) ([]*GameVersionFeatureValue, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*GameVersionFeatureValue, error)
+	Index(opts ...Option) ([]*GameVersionFeatureValue, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*GameVersionFeatureValue, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ GameVersionFeatureValueAPI = (*GameVersionFeatureValueService)(nil)
+
+// GameVideoAPI is the interface implemented by GameVideoService. Depend on GameVideoAPI
+// instead of *GameVideoService to substitute a mock, such as igdbmock.GameVideoAPI, in tests.
+// It only covers the basic operations of the service.
+type GameVideoAPI interface {
+	Get(id int, opts ...Option) (*GameVideo, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*GameVideo, error)
+	List(ids []int, opts ...Option) ([]*GameVideo, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*GameVideo, error)
+	Index(opts ...Option) ([]*GameVideo, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*GameVideo, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ GameVideoAPI = (*GameVideoService)(nil)
+
+// GenreAPI is the interface implemented by GenreService. Depend on GenreAPI
+// instead of *GenreService to substitute a mock, such as igdbmock.GenreAPI, in tests.
+// It only covers the basic operations of the service.
+type GenreAPI interface {
+	Get(id int, opts ...Option) (*Genre, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*Genre, error)
+	List(ids []int, opts ...Option) ([]*Genre, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*Genre, error)
+	Index(opts ...Option) ([]*Genre, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*Genre, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ GenreAPI = (*GenreService)(nil)
+
+// InvolvedCompanyAPI is the interface implemented by InvolvedCompanyService. Depend on InvolvedCompanyAPI
+// instead of *InvolvedCompanyService to substitute a mock, such as igdbmock.InvolvedCompanyAPI, in tests.
+// It only covers the basic operations of the service.
+type InvolvedCompanyAPI interface {
+	Get(id int, opts ...Option) (*InvolvedCompany, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*InvolvedCompany, error)
+	List(ids []int, opts ...Option) ([]*InvolvedCompany, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*InvolvedCompany, error)
+	Index(opts ...Option) ([]*InvolvedCompany, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*InvolvedCompany, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ InvolvedCompanyAPI = (*InvolvedCompanyService)(nil)
+
+// KeywordAPI is the interface implemented by KeywordService. Depend on KeywordAPI
+// instead of *KeywordService to substitute a mock, such as igdbmock.KeywordAPI, in tests.
+// It only covers the basic operations of the service.
+type KeywordAPI interface {
+	Get(id int, opts ...Option) (*Keyword, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*Keyword, error)
+	List(ids []int, opts ...Option) ([]*Keyword, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*Keyword, error)
+	Index(opts ...Option) ([]*Keyword, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*Keyword, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ KeywordAPI = (*KeywordService)(nil)
+
+// MultiplayerModeAPI is the interface implemented by MultiplayerModeService. Depend on MultiplayerModeAPI
+// instead of *MultiplayerModeService to substitute a mock, such as igdbmock.MultiplayerModeAPI, in tests.
+// It only covers the basic operations of the service.
+type MultiplayerModeAPI interface {
+	Get(id int, opts ...Option) (*MultiplayerMode, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*MultiplayerMode, error)
+	List(ids []int, opts ...Option) ([]*MultiplayerMode, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*MultiplayerMode, error)
+	Index(opts ...Option) ([]*MultiplayerMode, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*MultiplayerMode, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ MultiplayerModeAPI = (*MultiplayerModeService)(nil)
+
+// PageAPI is the interface implemented by PageService. Depend on PageAPI
+// instead of *PageService to substitute a mock, such as igdbmock.PageAPI, in tests.
+// It only covers the basic operations of the service.
+type PageAPI interface {
+	Get(id int, opts ...Option) (*Page, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*Page, error)
+	List(ids []int, opts ...Option) ([]*Page, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*Page, error)
+	Index(opts ...Option) ([]*Page, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*Page, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ PageAPI = (*PageService)(nil)
+
+// PageBackgroundAPI is the interface implemented by PageBackgroundService. Depend on PageBackgroundAPI
+// instead of *PageBackgroundService to substitute a mock, such as igdbmock.PageBackgroundAPI, in tests.
+// It only covers the basic operations of the service.
+type PageBackgroundAPI interface {
+	Get(id int, opts ...Option) (*PageBackground, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*PageBackground, error)
+	List(ids []int, opts ...Option) ([]*PageBackground, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*PageBackground, error)
+	Index(opts ...Option) ([]*PageBackground, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*PageBackground, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ PageBackgroundAPI = (*PageBackgroundService)(nil)
+
+// PageLogoAPI is the interface implemented by PageLogoService. Depend on PageLogoAPI
+// instead of *PageLogoService to substitute a mock, such as igdbmock.PageLogoAPI, in tests.
+// It only covers the basic operations of the service.
+type PageLogoAPI interface {
+	Get(id int, opts ...Option) (*PageLogo, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*PageLogo, error)
+	List(ids []int, opts ...Option) ([]*PageLogo, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*PageLogo, error)
+	Index(opts ...Option) ([]*PageLogo, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*PageLogo, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ PageLogoAPI = (*PageLogoService)(nil)
+
+// PageWebsiteAPI is the interface implemented by PageWebsiteService. Depend on PageWebsiteAPI
+// instead of *PageWebsiteService to substitute a mock, such as igdbmock.PageWebsiteAPI, in tests.
+// It only covers the basic operations of the service.
+type PageWebsiteAPI interface {
+	Get(id int, opts ...Option) (*PageWebsite, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*PageWebsite, error)
+	List(ids []int, opts ...Option) ([]*PageWebsite, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*PageWebsite, error)
+	Index(opts ...Option) ([]*PageWebsite, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*PageWebsite, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ PageWebsiteAPI = (*PageWebsiteService)(nil)
+
+// PlatformAPI is the interface implemented by PlatformService. Depend on PlatformAPI
+// instead of *PlatformService to substitute a mock, such as igdbmock.PlatformAPI, in tests.
+// It only covers the basic operations of the service.
+type PlatformAPI interface {
+	Get(id int, opts ...Option) (*Platform, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*Platform, error)
+	List(ids []int, opts ...Option) ([]*Platform, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*Platform, error)
+	Index(opts ...Option) ([]*Platform, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*Platform, error)
+	Search(qry string, opts ...Option) ([]*Platform, error)
+	SearchContext(ctx context.Context, qry string, opts ...Option) ([]*Platform, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ PlatformAPI = (*PlatformService)(nil)
+
+// PlatformLogoAPI is the interface implemented by PlatformLogoService. Depend on PlatformLogoAPI
+// instead of *PlatformLogoService to substitute a mock, such as igdbmock.PlatformLogoAPI, in tests.
+// It only covers the basic operations of the service.
+type PlatformLogoAPI interface {
+	Get(id int, opts ...Option) (*PlatformLogo, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*PlatformLogo, error)
+	List(ids []int, opts ...Option) ([]*PlatformLogo, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*PlatformLogo, error)
+	Index(opts ...Option) ([]*PlatformLogo, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*PlatformLogo, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ PlatformLogoAPI = (*PlatformLogoService)(nil)
+
+// PlatformVersionAPI is the interface implemented by PlatformVersionService. Depend on PlatformVersionAPI
+// instead of *PlatformVersionService to substitute a mock, such as igdbmock.PlatformVersionAPI, in tests.
+// It only covers the basic operations of the service.
+type PlatformVersionAPI interface {
+	Get(id int, opts ...Option) (*PlatformVersion, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*PlatformVersion, error)
+	List(ids []int, opts ...Option) ([]*PlatformVersion, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*PlatformVersion, error)
+	Index(opts ...Option) ([]*PlatformVersion, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*PlatformVersion, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ PlatformVersionAPI = (*PlatformVersionService)(nil)
+
+// PlatformVersionCompanyAPI is the interface implemented by PlatformVersionCompanyService. Depend on PlatformVersionCompanyAPI
+// instead of *PlatformVersionCompanyService to substitute a mock, such as igdbmock.PlatformVersionCompanyAPI, in tests.
+// It only covers the basic operations of the service.
+type PlatformVersionCompanyAPI interface {
+	Get(id int, opts ...Option) (*PlatformVersionCompany, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*PlatformVersionCompany, error)
+	List(ids []int, opts ...Option) ([]*PlatformVersionCompany, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*PlatformVersionCompany, error)
+	Index(opts ...Option) ([]*PlatformVersionCompany, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*PlatformVersionCompany, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ PlatformVersionCompanyAPI = (*PlatformVersionCompanyService)(nil)
+
+// PlatformVersionReleaseDateAPI is the interface implemented by PlatformVersionReleaseDateService. Depend on PlatformVersionReleaseDateAPI
+// instead of *PlatformVersionReleaseDateService to substitute a mock, such as igdbmock.PlatformVersionReleaseDateAPI, in tests.
+// It only covers the basic operations of the service.
+type PlatformVersionReleaseDateAPI interface {
+	Get(id int, opts ...Option) (*PlatformVersionReleaseDate, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*PlatformVersionReleaseDate, error)
+	List(ids []int, opts ...Option) ([]*PlatformVersionReleaseDate, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*PlatformVersionReleaseDate, error)
+	Index(opts ...Option) ([]*PlatformVersionReleaseDate, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*PlatformVersionReleaseDate, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ PlatformVersionReleaseDateAPI = (*PlatformVersionReleaseDateService)(nil)
+
+// PlatformWebsiteAPI is the interface implemented by PlatformWebsiteService. Depend on PlatformWebsiteAPI
+// instead of *PlatformWebsiteService to substitute a mock, such as igdbmock.PlatformWebsiteAPI, in tests.
+// It only covers the basic operations of the service.
+type PlatformWebsiteAPI interface {
+	Get(id int, opts ...Option) (*PlatformWebsite, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*PlatformWebsite, error)
+	List(ids []int, opts ...Option) ([]*PlatformWebsite, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*PlatformWebsite, error)
+	Index(opts ...Option) ([]*PlatformWebsite, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*PlatformWebsite, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ PlatformWebsiteAPI = (*PlatformWebsiteService)(nil)
+
+// PlayerPerspectiveAPI is the interface implemented by PlayerPerspectiveService. Depend on PlayerPerspectiveAPI
+// instead of *PlayerPerspectiveService to substitute a mock, such as igdbmock.PlayerPerspectiveAPI, in tests.
+// It only covers the basic operations of the service.
+type PlayerPerspectiveAPI interface {
+	Get(id int, opts ...Option) (*PlayerPerspective, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*PlayerPerspective, error)
+	List(ids []int, opts ...Option) ([]*PlayerPerspective, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*PlayerPerspective, error)
+	Index(opts ...Option) ([]*PlayerPerspective, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*PlayerPerspective, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ PlayerPerspectiveAPI = (*PlayerPerspectiveService)(nil)
+
+// ProductFamilyAPI is the interface implemented by ProductFamilyService. Depend on ProductFamilyAPI
+// instead of *ProductFamilyService to substitute a mock, such as igdbmock.ProductFamilyAPI, in tests.
+// It only covers the basic operations of the service.
+type ProductFamilyAPI interface {
+	Get(id int, opts ...Option) (*ProductFamily, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*ProductFamily, error)
+	List(ids []int, opts ...Option) ([]*ProductFamily, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*ProductFamily, error)
+	Index(opts ...Option) ([]*ProductFamily, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*ProductFamily, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ ProductFamilyAPI = (*ProductFamilyService)(nil)
+
+// PulseAPI is the interface implemented by PulseService. Depend on PulseAPI
+// instead of *PulseService to substitute a mock, such as igdbmock.PulseAPI, in tests.
+// It only covers the basic operations of the service.
+type PulseAPI interface {
+	Get(id int, opts ...Option) (*Pulse, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*Pulse, error)
+	List(ids []int, opts ...Option) ([]*Pulse, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*Pulse, error)
+	Index(opts ...Option) ([]*Pulse, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*Pulse, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ PulseAPI = (*PulseService)(nil)
+
+// PulseGroupAPI is the interface implemented by PulseGroupService. Depend on PulseGroupAPI
+// instead of *PulseGroupService to substitute a mock, such as igdbmock.PulseGroupAPI, in tests.
+// It only covers the basic operations of the service.
+type PulseGroupAPI interface {
+	Get(id int, opts ...Option) (*PulseGroup, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*PulseGroup, error)
+	List(ids []int, opts ...Option) ([]*PulseGroup, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*PulseGroup, error)
+	Index(opts ...Option) ([]*PulseGroup, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*PulseGroup, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ PulseGroupAPI = (*PulseGroupService)(nil)
+
+// PulseSourceAPI is the interface implemented by PulseSourceService. Depend on PulseSourceAPI
+// instead of *PulseSourceService to substitute a mock, such as igdbmock.PulseSourceAPI, in tests.
+// It only covers the basic operations of the service.
+type PulseSourceAPI interface {
+	Get(id int, opts ...Option) (*PulseSource, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*PulseSource, error)
+	List(ids []int, opts ...Option) ([]*PulseSource, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*PulseSource, error)
+	Index(opts ...Option) ([]*PulseSource, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*PulseSource, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ PulseSourceAPI = (*PulseSourceService)(nil)
+
+// PulseURLAPI is the interface implemented by PulseURLService. Depend on PulseURLAPI
+// instead of *PulseURLService to substitute a mock, such as igdbmock.PulseURLAPI, in tests.
+// It only covers the basic operations of the service.
+type PulseURLAPI interface {
+	Get(id int, opts ...Option) (*PulseURL, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*PulseURL, error)
+	List(ids []int, opts ...Option) ([]*PulseURL, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*PulseURL, error)
+	Index(opts ...Option) ([]*PulseURL, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*PulseURL, error)
+	Search(qry string, opts ...Option) ([]*PulseURL, error)
+	SearchContext(ctx context.Context, qry string, opts ...Option) ([]*PulseURL, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ PulseURLAPI = (*PulseURLService)(nil)
+
+// ReleaseDateAPI is the interface implemented by ReleaseDateService. Depend on ReleaseDateAPI
+// instead of *ReleaseDateService to substitute a mock, such as igdbmock.ReleaseDateAPI, in tests.
+// It only covers the basic operations of the service.
+type ReleaseDateAPI interface {
+	Get(id int, opts ...Option) (*ReleaseDate, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*ReleaseDate, error)
+	List(ids []int, opts ...Option) ([]*ReleaseDate, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*ReleaseDate, error)
+	Index(opts ...Option) ([]*ReleaseDate, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*ReleaseDate, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ ReleaseDateAPI = (*ReleaseDateService)(nil)
+
+// ScreenshotAPI is the interface implemented by ScreenshotService. Depend on ScreenshotAPI
+// instead of *ScreenshotService to substitute a mock, such as igdbmock.ScreenshotAPI, in tests.
+// It only covers the basic operations of the service.
+type ScreenshotAPI interface {
+	Get(id int, opts ...Option) (*Screenshot, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*Screenshot, error)
+	List(ids []int, opts ...Option) ([]*Screenshot, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*Screenshot, error)
+	Index(opts ...Option) ([]*Screenshot, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*Screenshot, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ ScreenshotAPI = (*ScreenshotService)(nil)
+
+// ThemeAPI is the interface implemented by ThemeService. Depend on ThemeAPI
+// instead of *ThemeService to substitute a mock, such as igdbmock.ThemeAPI, in tests.
+// It only covers the basic operations of the service.
+type ThemeAPI interface {
+	Get(id int, opts ...Option) (*Theme, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*Theme, error)
+	List(ids []int, opts ...Option) ([]*Theme, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*Theme, error)
+	Index(opts ...Option) ([]*Theme, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*Theme, error)
+	Search(qry string, opts ...Option) ([]*Theme, error)
+	SearchContext(ctx context.Context, qry string, opts ...Option) ([]*Theme, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ ThemeAPI = (*ThemeService)(nil)
+
+// TimeToBeatAPI is the interface implemented by TimeToBeatService. Depend on TimeToBeatAPI
+// instead of *TimeToBeatService to substitute a mock, such as igdbmock.TimeToBeatAPI, in tests.
+// It only covers the basic operations of the service.
+type TimeToBeatAPI interface {
+	Get(id int, opts ...Option) (*TimeToBeat, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*TimeToBeat, error)
+	List(ids []int, opts ...Option) ([]*TimeToBeat, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*TimeToBeat, error)
+	Index(opts ...Option) ([]*TimeToBeat, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*TimeToBeat, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ TimeToBeatAPI = (*TimeToBeatService)(nil)
+
+// TitleAPI is the interface implemented by TitleService. Depend on TitleAPI
+// instead of *TitleService to substitute a mock, such as igdbmock.TitleAPI, in tests.
+// It only covers the basic operations of the service.
+type TitleAPI interface {
+	Get(id int, opts ...Option) (*Title, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*Title, error)
+	List(ids []int, opts ...Option) ([]*Title, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*Title, error)
+	Index(opts ...Option) ([]*Title, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*Title, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ TitleAPI = (*TitleService)(nil)
+
+// WebsiteAPI is the interface implemented by WebsiteService. Depend on WebsiteAPI
+// instead of *WebsiteService to substitute a mock, such as igdbmock.WebsiteAPI, in tests.
+// It only covers the basic operations of the service.
+type WebsiteAPI interface {
+	Get(id int, opts ...Option) (*Website, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*Website, error)
+	List(ids []int, opts ...Option) ([]*Website, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*Website, error)
+	Index(opts ...Option) ([]*Website, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*Website, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ WebsiteAPI = (*WebsiteService)(nil)
+
+// CreditAPI is the interface implemented by CreditService. Depend on CreditAPI
+// instead of *CreditService to substitute a mock, such as igdbmock.CreditAPI, in tests.
+// It only covers the basic operations of the service.
+type CreditAPI interface {
+	Get(id int, opts ...Option) (*Credit, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*Credit, error)
+	List(ids []int, opts ...Option) ([]*Credit, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*Credit, error)
+	Index(opts ...Option) ([]*Credit, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*Credit, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ CreditAPI = (*CreditService)(nil)
+
+// FeedFollowAPI is the interface implemented by FeedFollowService. Depend on FeedFollowAPI
+// instead of *FeedFollowService to substitute a mock, such as igdbmock.FeedFollowAPI, in tests.
+// It only covers the basic operations of the service.
+type FeedFollowAPI interface {
+	Get(id int, opts ...Option) (*FeedFollow, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*FeedFollow, error)
+	List(ids []int, opts ...Option) ([]*FeedFollow, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*FeedFollow, error)
+	Index(opts ...Option) ([]*FeedFollow, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*FeedFollow, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ FeedFollowAPI = (*FeedFollowService)(nil)
+
+// FollowAPI is the interface implemented by FollowService. Depend on FollowAPI
+// instead of *FollowService to substitute a mock, such as igdbmock.FollowAPI, in tests.
+// It only covers the basic operations of the service.
+type FollowAPI interface {
+	Get(id int, opts ...Option) (*Follow, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*Follow, error)
+	List(ids []int, opts ...Option) ([]*Follow, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*Follow, error)
+	Index(opts ...Option) ([]*Follow, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*Follow, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ FollowAPI = (*FollowService)(nil)
+
+// ListAPI is the interface implemented by ListService. Depend on ListAPI
+// instead of *ListService to substitute a mock, such as igdbmock.ListAPI, in tests.
+// It only covers the basic operations of the service.
+type ListAPI interface {
+	Get(id int, opts ...Option) (*List, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*List, error)
+	List(ids []int, opts ...Option) ([]*List, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*List, error)
+	Index(opts ...Option) ([]*List, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*List, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ ListAPI = (*ListService)(nil)
+
+// ListEntryAPI is the interface implemented by ListEntryService. Depend on ListEntryAPI
+// instead of *ListEntryService to substitute a mock, such as igdbmock.ListEntryAPI, in tests.
+// It only covers the basic operations of the service.
+type ListEntryAPI interface {
+	Get(id int, opts ...Option) (*ListEntry, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*ListEntry, error)
+	List(ids []int, opts ...Option) ([]*ListEntry, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*ListEntry, error)
+	Index(opts ...Option) ([]*ListEntry, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*ListEntry, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ ListEntryAPI = (*ListEntryService)(nil)
+
+// PersonAPI is the interface implemented by PersonService. Depend on PersonAPI
+// instead of *PersonService to substitute a mock, such as igdbmock.PersonAPI, in tests.
+// It only covers the basic operations of the service.
+type PersonAPI interface {
+	Get(id int, opts ...Option) (*Person, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*Person, error)
+	List(ids []int, opts ...Option) ([]*Person, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*Person, error)
+	Index(opts ...Option) ([]*Person, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*Person, error)
+	Search(qry string, opts ...Option) ([]*Person, error)
+	SearchContext(ctx context.Context, qry string, opts ...Option) ([]*Person, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ PersonAPI = (*PersonService)(nil)
+
+// PersonMugshotAPI is the interface implemented by PersonMugshotService. Depend on PersonMugshotAPI
+// instead of *PersonMugshotService to substitute a mock, such as igdbmock.PersonMugshotAPI, in tests.
+// It only covers the basic operations of the service.
+type PersonMugshotAPI interface {
+	Get(id int, opts ...Option) (*PersonMugshot, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*PersonMugshot, error)
+	List(ids []int, opts ...Option) ([]*PersonMugshot, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*PersonMugshot, error)
+	Index(opts ...Option) ([]*PersonMugshot, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*PersonMugshot, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ PersonMugshotAPI = (*PersonMugshotService)(nil)
+
+// PersonWebsiteAPI is the interface implemented by PersonWebsiteService. Depend on PersonWebsiteAPI
+// instead of *PersonWebsiteService to substitute a mock, such as igdbmock.PersonWebsiteAPI, in tests.
+// It only covers the basic operations of the service.
+type PersonWebsiteAPI interface {
+	Get(id int, opts ...Option) (*PersonWebsite, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*PersonWebsite, error)
+	List(ids []int, opts ...Option) ([]*PersonWebsite, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*PersonWebsite, error)
+	Index(opts ...Option) ([]*PersonWebsite, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*PersonWebsite, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ PersonWebsiteAPI = (*PersonWebsiteService)(nil)
+
+// RateAPI is the interface implemented by RateService. Depend on RateAPI
+// instead of *RateService to substitute a mock, such as igdbmock.RateAPI, in tests.
+// It only covers the basic operations of the service.
+type RateAPI interface {
+	Get(id int, opts ...Option) (*Rate, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*Rate, error)
+	List(ids []int, opts ...Option) ([]*Rate, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*Rate, error)
+	Index(opts ...Option) ([]*Rate, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*Rate, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ RateAPI = (*RateService)(nil)
+
+// ReviewAPI is the interface implemented by ReviewService. Depend on ReviewAPI
+// instead of *ReviewService to substitute a mock, such as igdbmock.ReviewAPI, in tests.
+// It only covers the basic operations of the service.
+type ReviewAPI interface {
+	Get(id int, opts ...Option) (*Review, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*Review, error)
+	List(ids []int, opts ...Option) ([]*Review, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*Review, error)
+	Index(opts ...Option) ([]*Review, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*Review, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ ReviewAPI = (*ReviewService)(nil)
+
+// ReviewVideoAPI is the interface implemented by ReviewVideoService. Depend on ReviewVideoAPI
+// instead of *ReviewVideoService to substitute a mock, such as igdbmock.ReviewVideoAPI, in tests.
+// It only covers the basic operations of the service.
+type ReviewVideoAPI interface {
+	Get(id int, opts ...Option) (*ReviewVideo, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*ReviewVideo, error)
+	List(ids []int, opts ...Option) ([]*ReviewVideo, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*ReviewVideo, error)
+	Index(opts ...Option) ([]*ReviewVideo, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*ReviewVideo, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ ReviewVideoAPI = (*ReviewVideoService)(nil)
+
+// SocialMetricAPI is the interface implemented by SocialMetricService. Depend on SocialMetricAPI
+// instead of *SocialMetricService to substitute a mock, such as igdbmock.SocialMetricAPI, in tests.
+// It only covers the basic operations of the service.
+type SocialMetricAPI interface {
+	Get(id int, opts ...Option) (*SocialMetric, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*SocialMetric, error)
+	List(ids []int, opts ...Option) ([]*SocialMetric, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*SocialMetric, error)
+	Index(opts ...Option) ([]*SocialMetric, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*SocialMetric, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ SocialMetricAPI = (*SocialMetricService)(nil)
+
+// TestDummyAPI is the interface implemented by TestDummyService. Depend on TestDummyAPI
+// instead of *TestDummyService to substitute a mock, such as igdbmock.TestDummyAPI, in tests.
+// It only covers the basic operations of the service.
+type TestDummyAPI interface {
+	Get(id int, opts ...Option) (*TestDummy, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*TestDummy, error)
+	List(ids []int, opts ...Option) ([]*TestDummy, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*TestDummy, error)
+	Index(opts ...Option) ([]*TestDummy, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*TestDummy, error)
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ TestDummyAPI = (*TestDummyService)(nil)
@@ -0,0 +1,212 @@
+package igdb
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/Henry-Sarabia/blank"
+	"github.com/pkg/errors"
+)
+
+// TwitchTokenURL is the default URL used to request app access tokens from Twitch.
+const TwitchTokenURL string = "https://id.twitch.tv/oauth2/token"
+
+// tokenExpiryDelta is how long before its actual expiration a cached
+// access token is considered expired and refreshed. Tokens expiring
+// sooner than that are cached until their actual expiration.
+const tokenExpiryDelta = time.Minute
+
+// Errors returned when authenticating requests.
+var (
+	// ErrMissingCredentials occurs when an Authenticator is missing a client ID or secret.
+	ErrMissingCredentials = errors.New("client ID and client secret cannot be empty")
+	// ErrTokenRequest occurs when an app access token cannot be retrieved from the token URL.
+	ErrTokenRequest = errors.New("cannot retrieve app access token")
+)
+
+// Authenticator adds credentials to the requests sent to the IGDB.
+type Authenticator interface {
+	// Authenticate adds the necessary authentication headers to the provided
+	// request. The provided context is used for any request the Authenticator
+	// has to make on its own, such as retrieving an access token.
+	Authenticate(ctx context.Context, req *http.Request) error
+}
+
+// invalidator is implemented by an Authenticator that caches its credentials.
+// The Client invalidates the cached credentials after an unauthorized response
+// and retries the request once with fresh credentials.
+type invalidator interface {
+	Invalidate()
+}
+
+// keyAuth authenticates requests with a legacy IGDB API key.
+type keyAuth string
+
+// Authenticate adds the API key as a user-key header.
+func (k keyAuth) Authenticate(ctx context.Context, req *http.Request) error {
+	req.Header.Set("user-key", string(k))
+	return nil
+}
+
+// TwitchAuth authenticates requests using the Twitch OAuth client credentials
+// flow. An app access token is retrieved from TokenURL using the client ID and
+// secret, cached, and refreshed shortly before it expires.
+//
+// For more information visit: https://api-docs.igdb.com/#authentication
+type TwitchAuth struct {
+	// ClientID is the Twitch Client-ID of your application.
+	ClientID string
+	// ClientSecret is the Twitch client secret of your application.
+	ClientSecret string
+	// TokenURL is the URL used to request app access tokens. If empty,
+	// TwitchTokenURL is used instead.
+	TokenURL string
+	// HTTPClient is the client used to request app access tokens. If nil,
+	// a default HTTP client is used instead.
+	HTTPClient *http.Client
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+	now    func() time.Time
+}
+
+// NewTwitchAuth returns a TwitchAuth configured with the provided Twitch
+// Client-ID and client secret. The provided HTTP Client will be used to
+// retrieve app access tokens. If no HTTP Client is provided, a default
+// HTTP client is used instead.
+//
+// If you need a Twitch Client-ID and secret, please visit: https://dev.twitch.tv/console/apps
+func NewTwitchAuth(clientID, clientSecret string, custom *http.Client) *TwitchAuth {
+	return &TwitchAuth{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		HTTPClient:   custom,
+	}
+}
+
+// twitchToken is the response returned from the Twitch token endpoint.
+type twitchToken struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int    `json:"expires_in"`
+	TokenType   string `json:"token_type"`
+}
+
+// Authenticate adds the Client-ID and Authorization headers to the provided
+// request, retrieving a new app access token first if the cached token is
+// missing or about to expire.
+func (a *TwitchAuth) Authenticate(ctx context.Context, req *http.Request) error {
+	tok, err := a.Token(ctx)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Client-ID", a.ClientID)
+	req.Header.Set("Authorization", "Bearer "+tok)
+
+	return nil
+}
+
+// Token returns the cached app access token. If the cached token is missing
+// or about to expire, a new token is retrieved from the TokenURL.
+func (a *TwitchAuth) Token(ctx context.Context) (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.token != "" && a.clock().Before(a.expiry) {
+		return a.token, nil
+	}
+
+	tok, err := a.fetch(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	life := time.Duration(tok.ExpiresIn) * time.Second
+	if life > tokenExpiryDelta {
+		life -= tokenExpiryDelta
+	}
+
+	a.token = tok.AccessToken
+	a.expiry = a.clock().Add(life)
+
+	return a.token, nil
+}
+
+// Invalidate discards the cached app access token so that the
+// next request retrieves a new one.
+func (a *TwitchAuth) Invalidate() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.token = ""
+	a.expiry = time.Time{}
+}
+
+// fetch requests a new app access token from the TokenURL.
+func (a *TwitchAuth) fetch(ctx context.Context) (*twitchToken, error) {
+	if blank.Is(a.ClientID) || blank.Is(a.ClientSecret) {
+		return nil, ErrMissingCredentials
+	}
+
+	u := a.TokenURL
+	if u == "" {
+		u = TwitchTokenURL
+	}
+
+	q := url.Values{}
+	q.Set("client_id", a.ClientID)
+	q.Set("client_secret", a.ClientSecret)
+	q.Set("grant_type", "client_credentials")
+
+	req, err := http.NewRequest("POST", u+"?"+q.Encode(), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create token request")
+	}
+	req = req.WithContext(ctx)
+
+	hc := a.HTTPClient
+	if hc == nil {
+		hc = http.DefaultClient
+	}
+
+	resp, err := hc.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "http client cannot send token request")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Wrapf(ErrTokenRequest, "token URL returned status %d", resp.StatusCode)
+	}
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot read token response body")
+	}
+
+	var tok twitchToken
+	if err := json.Unmarshal(b, &tok); err != nil {
+		return nil, errors.Wrap(ErrInvalidJSON, err.Error())
+	}
+
+	if tok.AccessToken == "" {
+		return nil, errors.Wrap(ErrTokenRequest, "token response is missing an access token")
+	}
+
+	return &tok, nil
+}
+
+// clock returns the current time.
+func (a *TwitchAuth) clock() time.Time {
+	if a.now != nil {
+		return a.now()
+	}
+
+	return time.Now()
+}
@@ -0,0 +1,225 @@
+package igdb
+
+import (
+	"context"
+	"fmt"
+	"github.com/pkg/errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// startTokenServer initializes and returns a test server that mimics the Twitch token
+// endpoint. Each app access token it issues is numbered and expires after the provided
+// number of seconds. The provided counter is incremented for each token issued.
+func startTokenServer(status int, expiresIn int, issued *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if status != http.StatusOK {
+			w.WriteHeader(status)
+			return
+		}
+
+		if r.Method != "POST" || r.URL.Query().Get("grant_type") != "client_credentials" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		n := atomic.AddInt32(issued, 1)
+		fmt.Fprintf(w, `{"access_token": "token%d", "expires_in": %d, "token_type": "bearer"}`, n, expiresIn)
+	}))
+}
+
+func TestTwitchAuth_Token(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		secret     string
+		status     int
+		wantToken  string
+		wantIssued int32
+		wantErr    error
+	}{
+		{"Valid credentials", "someid", "somesecret", http.StatusOK, "token1", 1, nil},
+		{"Missing client ID", "", "somesecret", http.StatusOK, "", 0, ErrMissingCredentials},
+		{"Missing client secret", "someid", "", http.StatusOK, "", 0, ErrMissingCredentials},
+		{"Rejected credentials", "someid", "somesecret", http.StatusForbidden, "", 0, ErrTokenRequest},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var issued int32
+			ts := startTokenServer(test.status, 3600, &issued)
+			defer ts.Close()
+
+			a := NewTwitchAuth(test.id, test.secret, ts.Client())
+			a.TokenURL = ts.URL
+
+			for i := 0; i < 3; i++ {
+				tok, err := a.Token(context.Background())
+				if errors.Cause(err) != test.wantErr {
+					t.Fatalf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+				}
+
+				if tok != test.wantToken {
+					t.Errorf("got: <%v>, want: <%v>", tok, test.wantToken)
+				}
+			}
+
+			if issued != test.wantIssued {
+				t.Errorf("got: <%v> tokens issued, want: <%v>", issued, test.wantIssued)
+			}
+		})
+	}
+}
+
+func TestTwitchAuth_Refresh(t *testing.T) {
+	var issued int32
+	ts := startTokenServer(http.StatusOK, 120, &issued)
+	defer ts.Close()
+
+	now := time.Now()
+	a := NewTwitchAuth("someid", "somesecret", ts.Client())
+	a.TokenURL = ts.URL
+	a.now = func() time.Time { return now }
+
+	tests := []struct {
+		name      string
+		elapsed   time.Duration
+		wantToken string
+	}{
+		{"Initial token", 0, "token1"},
+		{"Cached token", 30 * time.Second, "token1"},
+		{"Token about to expire", 61 * time.Second, "token2"},
+		{"Refreshed token cached", 90 * time.Second, "token2"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			a.now = func() time.Time { return now.Add(test.elapsed) }
+
+			tok, err := a.Token(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tok != test.wantToken {
+				t.Errorf("got: <%v>, want: <%v>", tok, test.wantToken)
+			}
+		})
+	}
+}
+
+func TestTwitchAuth_ShortLivedToken(t *testing.T) {
+	var issued int32
+	ts := startTokenServer(http.StatusOK, 30, &issued)
+	defer ts.Close()
+
+	now := time.Now()
+	a := NewTwitchAuth("someid", "somesecret", ts.Client())
+	a.TokenURL = ts.URL
+	a.now = func() time.Time { return now }
+
+	tests := []struct {
+		name      string
+		elapsed   time.Duration
+		wantToken string
+	}{
+		{"Initial token", 0, "token1"},
+		{"Cached token", 29 * time.Second, "token1"},
+		{"Expired token", 31 * time.Second, "token2"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			a.now = func() time.Time { return now.Add(test.elapsed) }
+
+			tok, err := a.Token(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tok != test.wantToken {
+				t.Errorf("got: <%v>, want: <%v>", tok, test.wantToken)
+			}
+		})
+	}
+}
+
+func TestTwitchAuth_Authenticate(t *testing.T) {
+	var issued int32
+	ts := startTokenServer(http.StatusOK, 3600, &issued)
+	defer ts.Close()
+
+	a := NewTwitchAuth("someid", "somesecret", ts.Client())
+	a.TokenURL = ts.URL
+
+	req, err := http.NewRequest("POST", "http://fake.com/", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Authenticate(context.Background(), req); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := req.Header.Get("Client-ID"); got != "someid" {
+		t.Errorf("got: <%v>, want: <%v>", got, "someid")
+	}
+
+	if got := req.Header.Get("Authorization"); got != "Bearer token1" {
+		t.Errorf("got: <%v>, want: <%v>", got, "Bearer token1")
+	}
+}
+
+func TestClient_UnauthorizedRetry(t *testing.T) {
+	tests := []struct {
+		name       string
+		rejections int32
+		wantIssued int32
+		wantCalls  int32
+		wantErr    error
+	}{
+		{"Accepted token", 0, 1, 1, nil},
+		{"Expired token", 1, 2, 2, nil},
+		{"Rejected credentials", 5, 2, 2, ErrUnauthorized},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var issued int32
+			tokSrv := startTokenServer(http.StatusOK, 3600, &issued)
+			defer tokSrv.Close()
+
+			var calls int32
+			apiSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := atomic.AddInt32(&calls, 1)
+				b, _ := ioutil.ReadAll(r.Body)
+				if n <= test.rejections || !strings.HasPrefix(r.Header.Get("Authorization"), "Bearer ") || string(b) != "limit 5; " {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				fmt.Fprint(w, testResult)
+			}))
+			defer apiSrv.Close()
+
+			c := NewTwitchClient("someid", "somesecret", apiSrv.Client())
+			c.rootURL = apiSrv.URL + "/"
+			c.auth.(*TwitchAuth).TokenURL = tokSrv.URL
+
+			res := testResultPlaceholder{}
+
+			err := c.get(context.Background(), testEndpoint, &res, SetLimit(5))
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if issued != test.wantIssued {
+				t.Errorf("got: <%v> tokens issued, want: <%v>", issued, test.wantIssued)
+			}
+
+			if calls != test.wantCalls {
+				t.Errorf("got: <%v> API calls, want: <%v>", calls, test.wantCalls)
+			}
+		})
+	}
+}
@@ -0,0 +1,348 @@
+package igdb
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Henry-Sarabia/apicalypse"
+)
+
+// Cache stores the raw responses of API calls so that identical queries can be
+// answered without contacting the IGDB. Responses are keyed by their endpoint
+// and rendered query. A Cache must be safe for concurrent use.
+type Cache interface {
+	// Get returns the response stored under the provided key, if it exists
+	// and has not expired.
+	Get(key string) ([]byte, bool)
+	// Set stores the provided response under the provided key until the
+	// provided TTL elapses.
+	Set(key string, val []byte, ttl time.Duration)
+	// Delete removes the response stored under the provided key, if any.
+	Delete(key string)
+}
+
+// CacheTTL configures how long the responses of each endpoint are cached.
+type CacheTTL struct {
+	// Default is the TTL of the endpoints without a TTL of their own.
+	// If zero, those endpoints are not cached.
+	Default time.Duration
+	// Endpoints is the TTL of each individual endpoint (e.g. EndpointGenre).
+	// The TTL of an endpoint applies to its count and meta endpoints as well.
+	Endpoints map[endpoint]time.Duration
+}
+
+// DefaultCacheTTL caches the reference data of the IGDB, which rarely changes,
+// for a day and does not cache any other endpoint.
+var DefaultCacheTTL = CacheTTL{
+	Endpoints: map[endpoint]time.Duration{
+		EndpointGameMode:          24 * time.Hour,
+		EndpointGenre:             24 * time.Hour,
+		EndpointPlatform:          24 * time.Hour,
+		EndpointPlayerPerspective: 24 * time.Hour,
+		EndpointTheme:             24 * time.Hour,
+	},
+}
+
+// ttl returns the TTL of the provided endpoint.
+func (t CacheTTL) ttl(end endpoint) time.Duration {
+	for _, suffix := range []string{"", "count", "meta"} {
+		if ttl, ok := t.Endpoints[endpoint(strings.TrimSuffix(string(end), suffix))]; ok {
+			return ttl
+		}
+	}
+
+	return t.Default
+}
+
+// WithCache is a client option used to cache the responses of API calls in the
+// provided Cache for as long as the provided CacheTTL allows. Use the SetCacheBypass
+// and SetCacheRefresh functional options to control the cache of a single API call.
+func WithCache(cache Cache, ttl CacheTTL) ClientOption {
+	return func(c *Client) {
+		c.cache = cache
+		c.cacheTTL = ttl
+	}
+}
+
+// cacheMode controls how a single API call uses the cache.
+type cacheMode string
+
+// Available cache modes for a single API call.
+const (
+	cacheDefault cacheMode = ""
+	cacheBypass  cacheMode = "bypass"
+	cacheRefresh cacheMode = "refresh"
+)
+
+// Names of the query clauses that carry information for the Client instead of
+// the IGDB, such as the cache mode of an API call. Clauses prefixed with
+// metaPrefix are not rendered into the query.
+const (
+	metaPrefix = "#"
+	metaCache  = metaPrefix + "cache"
+)
+
+// cacheModeKey is the context key of the cache mode of a request.
+type cacheModeKey struct{}
+
+// SetCacheBypass is a functional option used to send an API call to the IGDB
+// without reading from or writing to the Client's cache.
+func SetCacheBypass() Option {
+	return setCacheMode(cacheBypass)
+}
+
+// SetCacheRefresh is a functional option used to send an API call to the IGDB
+// without reading from the Client's cache, replacing any cached response with
+// the new one.
+func SetCacheRefresh() Option {
+	return setCacheMode(cacheRefresh)
+}
+
+// setCacheMode is a functional option used to set the cache mode of an API call.
+func setCacheMode(mode cacheMode) Option {
+	return func() (apicalypse.Option, error) {
+		return func(clauses map[string]string) error {
+			clauses[metaCache] = string(mode)
+			return nil
+		}, nil
+	}
+}
+
+// withCacheMode returns a copy of the provided context carrying the provided cache mode.
+func withCacheMode(ctx context.Context, mode cacheMode) context.Context {
+	if mode == cacheDefault {
+		return ctx
+	}
+
+	return context.WithValue(ctx, cacheModeKey{}, mode)
+}
+
+// cacheModeOf returns the cache mode carried by the provided request.
+func cacheModeOf(req *http.Request) cacheMode {
+	mode, _ := req.Context().Value(cacheModeKey{}).(cacheMode)
+	return mode
+}
+
+// cacheKey returns the key under which the response to the provided request is
+// cached: the endpoint of the request followed by its rendered query.
+func (c *Client) cacheKey(req *http.Request) string {
+	key := strings.TrimPrefix(req.URL.String(), c.rootURL)
+
+	if req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			b, _ := ioutil.ReadAll(body)
+			key += " " + string(b)
+		}
+	}
+
+	return key
+}
+
+// cachedFetch is like fetch but consults the Client's cache first and caches
+// successful responses, according to the TTL of the requested endpoint and the
+// cache mode of the request.
+func (c *Client) cachedFetch(req *http.Request) ([]byte, error) {
+	if c.cache == nil {
+		return c.fetch(req)
+	}
+
+	ttl := c.cacheTTL.ttl(endpoint(strings.TrimPrefix(req.URL.String(), c.rootURL)))
+	mode := cacheModeOf(req)
+	if ttl <= 0 || mode == cacheBypass {
+		return c.fetch(req)
+	}
+
+	key := c.cacheKey(req)
+	if mode != cacheRefresh {
+		if b, ok := c.cache.Get(key); ok {
+			return b, nil
+		}
+	}
+
+	b, err := c.fetch(req)
+	if err != nil {
+		return nil, err
+	}
+
+	c.cache.Set(key, b, ttl)
+	return b, nil
+}
+
+// MemoryCache is an in-memory Cache that holds up to a fixed number of
+// responses. Once full, the least recently used response is evicted to
+// make room for a new one.
+type MemoryCache struct {
+	mu      sync.Mutex
+	size    int
+	entries map[string]*list.Element
+	order   *list.List
+	now     func() time.Time
+}
+
+// memoryEntry is a response stored in a MemoryCache.
+type memoryEntry struct {
+	key    string
+	val    []byte
+	expiry time.Time
+}
+
+// NewMemoryCache returns a MemoryCache that holds up to the provided number
+// of responses. If size is less than 1, the number of responses is not capped.
+func NewMemoryCache(size int) *MemoryCache {
+	return &MemoryCache{
+		size:    size,
+		entries: make(map[string]*list.Element),
+		order:   list.New(),
+		now:     time.Now,
+	}
+}
+
+// Get returns the response stored under the provided key, if it exists
+// and has not expired. The response becomes the most recently used.
+func (m *MemoryCache) Get(key string) ([]byte, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	el, ok := m.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	e := el.Value.(*memoryEntry)
+	if !m.now().Before(e.expiry) {
+		m.remove(el)
+		return nil, false
+	}
+
+	m.order.MoveToFront(el)
+	return e.val, true
+}
+
+// Set stores the provided response under the provided key until the provided
+// TTL elapses, evicting the least recently used response if the MemoryCache
+// is full.
+func (m *MemoryCache) Set(key string, val []byte, ttl time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	e := &memoryEntry{key: key, val: val, expiry: m.now().Add(ttl)}
+
+	if el, ok := m.entries[key]; ok {
+		el.Value = e
+		m.order.MoveToFront(el)
+		return
+	}
+
+	m.entries[key] = m.order.PushFront(e)
+
+	for m.size > 0 && m.order.Len() > m.size {
+		m.remove(m.order.Back())
+	}
+}
+
+// Delete removes the response stored under the provided key, if any.
+func (m *MemoryCache) Delete(key string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if el, ok := m.entries[key]; ok {
+		m.remove(el)
+	}
+}
+
+// Len returns the number of responses stored in the MemoryCache,
+// including any that have expired but not yet been evicted.
+func (m *MemoryCache) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.order.Len()
+}
+
+// remove removes the provided element from the MemoryCache.
+func (m *MemoryCache) remove(el *list.Element) {
+	m.order.Remove(el)
+	delete(m.entries, el.Value.(*memoryEntry).key)
+}
+
+// FileCache is a Cache that stores each response as a file in a directory,
+// allowing the cache to outlive the process. Expired responses are removed
+// when they are next read.
+type FileCache struct {
+	dir string
+	now func() time.Time
+}
+
+// NewFileCache returns a FileCache that stores responses in the provided
+// directory. The directory is created if it does not exist.
+func NewFileCache(dir string) (*FileCache, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	return &FileCache{dir: dir, now: time.Now}, nil
+}
+
+// path returns the path of the file storing the response under the provided key.
+func (f *FileCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(f.dir, hex.EncodeToString(sum[:]))
+}
+
+// Get returns the response stored under the provided key, if it exists and
+// has not expired. Any error reading the response is treated as a miss.
+func (f *FileCache) Get(key string) ([]byte, bool) {
+	b, err := ioutil.ReadFile(f.path(key))
+	if err != nil {
+		return nil, false
+	}
+
+	i := bytes.IndexByte(b, '\n')
+	if i < 0 {
+		return nil, false
+	}
+
+	exp, err := strconv.ParseInt(string(b[:i]), 10, 64)
+	if err != nil || !f.now().Before(time.Unix(0, exp)) {
+		f.Delete(key)
+		return nil, false
+	}
+
+	return b[i+1:], true
+}
+
+// Set stores the provided response under the provided key until the provided
+// TTL elapses. The response is written to a temporary file first so that a
+// partially written response is never read. Any error writing the response
+// is ignored.
+func (f *FileCache) Set(key string, val []byte, ttl time.Duration) {
+	tmp, err := ioutil.TempFile(f.dir, ".tmp-")
+	if err != nil {
+		return
+	}
+	defer os.Remove(tmp.Name())
+
+	exp := strconv.FormatInt(f.now().Add(ttl).UnixNano(), 10)
+	_, err = tmp.Write(append([]byte(exp+"\n"), val...))
+	if cerr := tmp.Close(); err != nil || cerr != nil {
+		return
+	}
+
+	os.Rename(tmp.Name(), f.path(key))
+}
+
+// Delete removes the response stored under the provided key, if any.
+func (f *FileCache) Delete(key string) {
+	os.Remove(f.path(key))
+}
@@ -0,0 +1,299 @@
+package igdb
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestMemoryCache(t *testing.T) {
+	now := time.Now()
+	m := NewMemoryCache(2)
+	m.now = func() time.Time { return now }
+
+	m.Set("a", []byte("1"), time.Minute)
+	m.Set("b", []byte("2"), time.Minute)
+
+	if _, ok := m.Get("a"); !ok {
+		t.Fatal("got: <false>, want: <true>")
+	}
+
+	// "b" is now the least recently used entry and is evicted.
+	m.Set("c", []byte("3"), time.Minute)
+
+	tests := []struct {
+		name    string
+		key     string
+		elapsed time.Duration
+		want    string
+		wantOK  bool
+	}{
+		{"Recently used", "a", 0, "1", true},
+		{"Evicted", "b", 0, "", false},
+		{"Newest", "c", 0, "3", true},
+		{"Missing", "d", 0, "", false},
+		{"Expired", "c", time.Minute, "", false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m.now = func() time.Time { return now.Add(test.elapsed) }
+
+			b, ok := m.Get(test.key)
+			if ok != test.wantOK {
+				t.Fatalf("got: <%v>, want: <%v>", ok, test.wantOK)
+			}
+
+			if string(b) != test.want {
+				t.Errorf("got: <%v>, want: <%v>", string(b), test.want)
+			}
+		})
+	}
+
+	m.Delete("a")
+	if m.Len() != 0 {
+		t.Errorf("got: <%v> entries, want: <%v>", m.Len(), 0)
+	}
+}
+
+func TestFileCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "igdb-cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	now := time.Now()
+	f, err := NewFileCache(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.now = func() time.Time { return now }
+
+	f.Set("games/ fields name; ", []byte(`[{"id": 1}]`), time.Minute)
+
+	b, ok := f.Get("games/ fields name; ")
+	if !ok || string(b) != `[{"id": 1}]` {
+		t.Errorf("got: <%v, %v>, want: <%v, %v>", string(b), ok, `[{"id": 1}]`, true)
+	}
+
+	if _, ok := f.Get("games/ fields slug; "); ok {
+		t.Error("got: <true>, want: <false>")
+	}
+
+	// A new FileCache in the same directory sees the stored response.
+	g, err := NewFileCache(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g.now = f.now
+
+	if _, ok := g.Get("games/ fields name; "); !ok {
+		t.Error("got: <false>, want: <true>")
+	}
+
+	f.now = func() time.Time { return now.Add(time.Minute) }
+	if _, ok := f.Get("games/ fields name; "); ok {
+		t.Error("got: <true>, want: <false>")
+	}
+
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(files) != 0 {
+		t.Errorf("got: <%v> files, want: <%v>", len(files), 0)
+	}
+
+	f.Set("a", []byte("1"), time.Hour)
+	f.Delete("a")
+	if _, ok := f.Get("a"); ok {
+		t.Error("got: <true>, want: <false>")
+	}
+}
+
+func TestClient_Cache(t *testing.T) {
+	var calls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&calls, 1)
+		if r.URL.Path == "/genres/count" {
+			fmt.Fprintf(w, `{"count": %d}`, n)
+			return
+		}
+		fmt.Fprintf(w, `[{"id": %d}]`, n)
+	}))
+	defer ts.Close()
+
+	c := NewClient(testKey, ts.Client(), WithBaseURL(ts.URL), WithCache(NewMemoryCache(10), DefaultCacheTTL))
+
+	tests := []struct {
+		name      string
+		call      func() (int, error)
+		wantID    int
+		wantCalls int32
+	}{
+		{
+			"First call",
+			func() (int, error) { g, err := c.Genres.Index(SetFields("name")); return idOrZero(g, err) },
+			1,
+			1,
+		},
+		{
+			"Cached call",
+			func() (int, error) { g, err := c.Genres.Index(SetFields("name")); return idOrZero(g, err) },
+			1,
+			1,
+		},
+		{
+			"Different query",
+			func() (int, error) { g, err := c.Genres.Index(SetFields("slug")); return idOrZero(g, err) },
+			2,
+			2,
+		},
+		{
+			"Bypassed cache",
+			func() (int, error) {
+				g, err := c.Genres.Index(SetFields("name"), SetCacheBypass())
+				return idOrZero(g, err)
+			},
+			3,
+			3,
+		},
+		{
+			"Still cached after bypass",
+			func() (int, error) { g, err := c.Genres.Index(SetFields("name")); return idOrZero(g, err) },
+			1,
+			3,
+		},
+		{
+			"Refreshed cache",
+			func() (int, error) {
+				g, err := c.Genres.Index(SetFields("name"), SetCacheRefresh())
+				return idOrZero(g, err)
+			},
+			4,
+			4,
+		},
+		{
+			"Cached after refresh",
+			func() (int, error) { g, err := c.Genres.Index(SetFields("name")); return idOrZero(g, err) },
+			4,
+			4,
+		},
+		{
+			"Uncached endpoint",
+			func() (int, error) {
+				g, err := c.Games.Index(SetFields("name"))
+				if err != nil {
+					return 0, err
+				}
+				return g[0].ID, nil
+			},
+			5,
+			5,
+		},
+		{
+			"Uncached endpoint again",
+			func() (int, error) {
+				g, err := c.Games.Index(SetFields("name"))
+				if err != nil {
+					return 0, err
+				}
+				return g[0].ID, nil
+			},
+			6,
+			6,
+		},
+		{"Count", func() (int, error) { return c.Genres.Count() }, 7, 7},
+		{"Cached count", func() (int, error) { return c.Genres.Count() }, 7, 7},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			id, err := test.call()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if id != test.wantID {
+				t.Errorf("got: <%v>, want: <%v>", id, test.wantID)
+			}
+
+			if calls != test.wantCalls {
+				t.Errorf("got: <%v> calls, want: <%v>", calls, test.wantCalls)
+			}
+		})
+	}
+}
+
+func TestClient_CacheErrors(t *testing.T) {
+	var calls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer ts.Close()
+
+	c := NewClient(testKey, ts.Client(), WithBaseURL(ts.URL), WithCache(NewMemoryCache(10), CacheTTL{Default: time.Hour}))
+
+	for i := 0; i < 2; i++ {
+		if _, err := c.Genres.IndexContext(context.Background()); err == nil {
+			t.Fatal("got: <nil>, want: error")
+		}
+	}
+
+	if calls != 2 {
+		t.Errorf("got: <%v> calls, want: <%v>", calls, 2)
+	}
+}
+
+func TestCacheTTL_TTL(t *testing.T) {
+	ttl := CacheTTL{
+		Default:   time.Minute,
+		Endpoints: map[endpoint]time.Duration{EndpointGenre: time.Hour, EndpointGame: 0},
+	}
+
+	tests := []struct {
+		name string
+		end  endpoint
+		want time.Duration
+	}{
+		{"Endpoint TTL", EndpointGenre, time.Hour},
+		{"Count endpoint TTL", EndpointGenre + "count", time.Hour},
+		{"Meta endpoint TTL", EndpointGenre + "meta", time.Hour},
+		{"Disabled endpoint", EndpointGame, 0},
+		{"Default TTL", EndpointTheme, time.Minute},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := ttl.ttl(test.end); got != test.want {
+				t.Errorf("got: <%v>, want: <%v>", got, test.want)
+			}
+		})
+	}
+}
+
+func TestRenderQuery_Meta(t *testing.T) {
+	qry, err := renderQuery(SetFields("name"), SetCacheBypass(), SetLimit(5))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := "fields name; limit 5; "; qry != want {
+		t.Errorf("got: <%v>, want: <%v>", qry, want)
+	}
+}
+
+// idOrZero returns the ID of the first of the provided Genres, if any.
+func idOrZero(g []*Genre, err error) (int, error) {
+	if err != nil || len(g) == 0 {
+		return 0, err
+	}
+
+	return g[0].ID, nil
+}
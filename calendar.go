@@ -0,0 +1,311 @@
+package igdb
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/Henry-Sarabia/apicalypse"
+	"github.com/pkg/errors"
+)
+
+// ErrInvalidWindow occurs when a release calendar is requested
+// for a window that ends before it starts.
+var ErrInvalidWindow = errors.New("calendar window must end after it starts")
+
+// DefaultUIDDomain is the domain name used to make the UIDs of the events
+// written by WriteICS globally unique when a Calendar provides none.
+const DefaultUIDDomain = "igdb.henry-sarabia.github.io"
+
+// Calendar is a release calendar: the games that may release within a window
+// of time, grouped by the date they may release on.
+type Calendar struct {
+	// From and To delimit the window of the Calendar,
+	// including From and excluding To.
+	From time.Time
+	To   time.Time
+	// Groups lists the release dates within the window in chronological
+	// order, as sorted by FuzzyDate.Compare.
+	Groups []*CalendarGroup
+	// Generated is the time the Calendar was retrieved.
+	Generated time.Time
+	// UIDDomain is the domain name used to make the UIDs of the events
+	// written by WriteICS globally unique. It defaults to DefaultUIDDomain.
+	UIDDomain string
+}
+
+// CalendarGroup is the games releasing on a single date of a Calendar. The
+// date of a group is as precise as the release dates it groups, so a day, a
+// month, a quarter, and a year each make up a group of their own.
+type CalendarGroup struct {
+	Date    FuzzyDate
+	Entries []*CalendarEntry
+}
+
+// CalendarEntry is the release of a single game on a single date, on one or
+// more platforms and in one or more regions.
+type CalendarEntry struct {
+	Date      FuzzyDate
+	Game      Reference
+	Name      string
+	Platforms References
+	Regions   []RegionCategory
+	// ReleaseDates are the ReleaseDates making up the entry.
+	ReleaseDates []*ReleaseDate
+}
+
+// SetReleaseRegions is a functional option used to restrict the ReleaseDates
+// retrieved from an API call to those in the provided regions. It filters on
+// the region field of ReleaseDates and is meant for the ReleaseDateService.
+func SetReleaseRegions(regions ...RegionCategory) Option {
+	vals := make([]interface{}, len(regions))
+	for i, r := range regions {
+		vals[i] = r
+	}
+
+	return SetWhere(ContainsAny("region", vals...))
+}
+
+// SetReleasePlatforms is a functional option used to restrict the ReleaseDates
+// retrieved from an API call to those on the provided platforms, identified
+// by their IGDB IDs. It filters on the platform field of ReleaseDates and is
+// meant for the ReleaseDateService.
+func SetReleasePlatforms(ids ...int) Option {
+	vals := make([]interface{}, len(ids))
+	for i, id := range ids {
+		vals[i] = id
+	}
+
+	return SetWhere(ContainsAny("platform", vals...))
+}
+
+// Calendar returns the release calendar of the provided window, including
+// from and excluding to. Release dates known only to a month, quarter, or year
+// are included if they may fall within the window, while release dates to be
+// determined are excluded. Provide the SetReleaseRegions and
+// SetReleasePlatforms functional options to restrict the calendar to specific
+// regions and platforms. Every release date within the window is retrieved
+// with Scan, one page at a time, so the calendar is not limited by the
+// maximum offset of the IGDB.
+func (rs *ReleaseDateService) Calendar(ctx context.Context, from, to time.Time, opts ...Option) (*Calendar, error) {
+	if !to.After(from) {
+		return nil, ErrInvalidWindow
+	}
+
+	// Vague release dates are stored as a single date within the period
+	// they span, which is never more than a year.
+	exact := And(Eq("category", DateYYYYMMMMDD), Gte("date", from), Lt("date", to))
+	vague := And(NotEq("category", DateYYYYMMMMDD), NotEq("category", DateTBD), Gt("date", from.AddDate(-1, 0, 0)), Lt("date", to.AddDate(1, 0, 0)))
+
+	// The ID and game name of every release date are required to page
+	// through the window and to group its entries, whatever fields the
+	// caller requests.
+	opts = append([]Option{SetFields("*")}, opts...)
+	opts = append(opts, appendFields("id", "game.name"), SetWhere(Or(exact, vague)))
+
+	cal := &Calendar{From: from, To: to, Generated: time.Now()}
+
+	it := rs.Scan(ctx, 0, opts...)
+	for it.Next() {
+		r := it.Value()
+		if d := r.FuzzyDate(); d.Overlaps(from, to) {
+			cal.add(d, r)
+		}
+	}
+	if err := it.Err(); err != nil {
+		return nil, errors.Wrap(err, "cannot get release calendar")
+	}
+
+	cal.sort()
+	return cal, nil
+}
+
+// appendFields is a functional option used to add the provided fields to the
+// fields requested by any previous option, rather than replacing them as
+// SetFields does. Fields already requested are not added again.
+func appendFields(fields ...string) Option {
+	return func() (apicalypse.Option, error) {
+		return func(filters map[string]string) error {
+			var req []string
+			if f, ok := filters["fields"]; ok && f != "" {
+				req = strings.Split(f, ",")
+			}
+
+			for _, f := range fields {
+				if !containsString(req, f) {
+					req = append(req, f)
+				}
+			}
+
+			filters["fields"] = strings.Join(req, ",")
+			return nil
+		}, nil
+	}
+}
+
+// add adds the provided ReleaseDate to the entry of its game in
+// the group of the provided date, creating either as needed.
+func (c *Calendar) add(d FuzzyDate, r *ReleaseDate) {
+	var grp *CalendarGroup
+	for _, g := range c.Groups {
+		if g.Date == d {
+			grp = g
+			break
+		}
+	}
+	if grp == nil {
+		grp = &CalendarGroup{Date: d}
+		c.Groups = append(c.Groups, grp)
+	}
+
+	var ent *CalendarEntry
+	for _, e := range grp.Entries {
+		if e.Game.ID == r.Game.ID {
+			ent = e
+			break
+		}
+	}
+	if ent == nil {
+		ent = &CalendarEntry{Date: d, Game: r.Game}
+		var g Game
+		if err := r.Game.Decode(&g); err == nil {
+			ent.Name = g.Name
+		}
+		grp.Entries = append(grp.Entries, ent)
+	}
+
+	ent.ReleaseDates = append(ent.ReleaseDates, r)
+	if r.Platform.ID != 0 && !containsRef(ent.Platforms, r.Platform.ID) {
+		ent.Platforms = append(ent.Platforms, r.Platform)
+	}
+	if r.Region != 0 && !containsRegion(ent.Regions, r.Region) {
+		ent.Regions = append(ent.Regions, r.Region)
+	}
+}
+
+// sort sorts the groups of the Calendar chronologically
+// and the entries of each group by name.
+func (c *Calendar) sort() {
+	sort.SliceStable(c.Groups, func(i, j int) bool {
+		return c.Groups[i].Date.Before(c.Groups[j].Date)
+	})
+
+	for _, g := range c.Groups {
+		sort.SliceStable(g.Entries, func(i, j int) bool {
+			a, b := g.Entries[i], g.Entries[j]
+			if a.Name != b.Name {
+				return a.Name < b.Name
+			}
+			return a.Game.ID < b.Game.ID
+		})
+	}
+}
+
+// containsRef returns true if the provided References contain the provided ID.
+func containsRef(refs References, id int) bool {
+	for _, r := range refs {
+		if r.ID == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+// containsString returns true if the provided strings contain the provided string.
+func containsString(strs []string, s string) bool {
+	for _, str := range strs {
+		if str == s {
+			return true
+		}
+	}
+
+	return false
+}
+
+// containsRegion returns true if the provided regions contain the provided region.
+func containsRegion(regions []RegionCategory, region RegionCategory) bool {
+	for _, r := range regions {
+		if r == region {
+			return true
+		}
+	}
+
+	return false
+}
+
+// WriteICS writes the Calendar to the provided writer as an iCalendar feed
+// (RFC 5545). Every entry with an exact release date becomes an all-day event,
+// while entries known only to a month, quarter, or year are left out.
+func (c *Calendar) WriteICS(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	stamp := c.Generated.UTC().Format("20060102T150405Z")
+
+	domain := c.UIDDomain
+	if domain == "" {
+		domain = DefaultUIDDomain
+	}
+
+	line := func(s string) {
+		// Lines longer than 75 octets are folded onto
+		// continuation lines starting with a space.
+		for len(s) > 75 {
+			n := 75
+			for n > 0 && !utf8.RuneStart(s[n]) {
+				n--
+			}
+			bw.WriteString(s[:n] + "\r\n")
+			s = " " + s[n:]
+		}
+		bw.WriteString(s + "\r\n")
+	}
+
+	line("BEGIN:VCALENDAR")
+	line("VERSION:2.0")
+	line("PRODID:-//Henry-Sarabia//igdb//EN")
+	line("CALSCALE:GREGORIAN")
+
+	for _, g := range c.Groups {
+		if g.Date.Precision != PrecisionDay {
+			continue
+		}
+
+		start := g.Date.Start()
+		for _, e := range g.Entries {
+			name := e.Name
+			if name == "" {
+				name = fmt.Sprintf("Game %d", e.Game.ID)
+			}
+
+			var regions []string
+			for _, r := range e.Regions {
+				regions = append(regions, strings.TrimPrefix(r.String(), "Region"))
+			}
+
+			line("BEGIN:VEVENT")
+			line(fmt.Sprintf("UID:igdb-release-%d-%s@%s", e.Game.ID, start.Format("20060102"), domain))
+			line("DTSTAMP:" + stamp)
+			line("DTSTART;VALUE=DATE:" + start.Format("20060102"))
+			line("DTEND;VALUE=DATE:" + g.Date.End().Format("20060102"))
+			line("SUMMARY:" + escapeICS(name))
+			if len(regions) > 0 {
+				line("DESCRIPTION:" + escapeICS("Regions: "+strings.Join(regions, ", ")))
+			}
+			line("TRANSP:TRANSPARENT")
+			line("END:VEVENT")
+		}
+	}
+
+	line("END:VCALENDAR")
+	return bw.Flush()
+}
+
+// escapeICS escapes the provided text for use as an iCalendar property value.
+func escapeICS(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(s)
+}
@@ -0,0 +1,227 @@
+package igdb
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// calendarReleases are the ReleaseDates served by startCalendarServer.
+const calendarReleases = `[
+	{"id": 1, "category": 0, "date": 1728950400, "y": 2024, "m": 10, "game": {"id": 100, "name": "Zeta; Part 1"}, "platform": 48, "region": 2},
+	{"id": 2, "category": 0, "date": 1728950400, "y": 2024, "m": 10, "game": {"id": 100, "name": "Zeta; Part 1"}, "platform": 6, "region": 1},
+	{"id": 3, "category": 0, "date": 1728950400, "y": 2024, "m": 10, "game": {"id": 101, "name": "Alpha"}, "platform": 48, "region": 8},
+	{"id": 4, "category": 1, "date": 1730332800, "y": 2024, "m": 10, "game": {"id": 102, "name": "Month"}, "platform": 6, "region": 8},
+	{"id": 5, "category": 6, "date": 1735603200, "y": 2024, "m": 12, "game": {"id": 103, "name": "Quarter"}, "platform": 6, "region": 8},
+	{"id": 6, "category": 5, "date": 1727654400, "y": 2024, "m": 9, "game": {"id": 104, "name": "Too Early"}, "platform": 6, "region": 8},
+	{"id": 7, "category": 2, "date": 1735603200, "y": 2024, "m": 12, "game": {"id": 105, "name": "Year"}, "platform": 6, "region": 8},
+	{"id": 8, "category": 0, "date": 1727740800, "y": 2024, "m": 10, "game": {"id": 106, "name": "First"}, "platform": 6, "region": 8}
+]`
+
+// startCalendarServer initializes and returns a test server that serves the
+// calendarReleases as a single page following ID 0. The body of each request
+// is recorded in the provided slice.
+func startCalendarServer(bodies *[]string) (*httptest.Server, *Client) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		*bodies = append(*bodies, string(b))
+
+		if strings.Contains(string(b), "id > 0 ") {
+			fmt.Fprint(w, calendarReleases)
+			return
+		}
+		fmt.Fprint(w, "[]")
+	}))
+
+	return ts, NewClient(testKey, ts.Client(), WithBaseURL(ts.URL))
+}
+
+func TestReleaseDateService_Calendar(t *testing.T) {
+	var bodies []string
+	ts, c := startCalendarServer(&bodies)
+	defer ts.Close()
+
+	from := time.Date(2024, time.October, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, time.November, 1, 0, 0, 0, 0, time.UTC)
+
+	cal, err := c.ReleaseDates.Calendar(context.Background(), from, to, SetReleaseRegions(RegionEurope, RegionNorthAmerica), SetReleasePlatforms(6, 48))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(bodies) < 1 {
+		t.Fatal("got: no requests, want: at least one request")
+	}
+	for _, want := range []string{"fields *,id,game.name;", "region = (1,2)", "platform = (6,48)", "category = 0 & date >= 1727740800 & date < 1730419200", "category != 7", "sort id asc;"} {
+		if !strings.Contains(bodies[0], want) {
+			t.Errorf("got: <%v>, want query containing: <%v>", bodies[0], want)
+		}
+	}
+
+	var got []string
+	for _, g := range cal.Groups {
+		var names []string
+		for _, e := range g.Entries {
+			names = append(names, fmt.Sprintf("%s %v %v", e.Name, e.Platforms.IDs(), e.Regions))
+		}
+		got = append(got, g.Date.String()+": "+strings.Join(names, ", "))
+	}
+
+	want := []string{
+		"2024: Year [6] [RegionWorldwide]",
+		"Oct 1, 2024: First [6] [RegionWorldwide]",
+		"Oct 2024: Month [6] [RegionWorldwide]",
+		"Q4 2024: Quarter [6] [RegionWorldwide]",
+		"Oct 15, 2024: Alpha [48] [RegionWorldwide], Zeta; Part 1 [48 6] [RegionNorthAmerica RegionEurope]",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("got: <%v>, want: <%v>", got, want)
+	}
+}
+
+func TestReleaseDateService_CalendarFields(t *testing.T) {
+	var tests = []struct {
+		name       string
+		opts       []Option
+		wantFields string
+	}{
+		{"Default fields", nil, "fields *,id,game.name;"},
+		{"Custom fields", []Option{SetFields("date", "category")}, "fields date,category,id,game.name;"},
+		{"Custom fields with ID", []Option{SetFields("id", "date", "game.name")}, "fields id,date,game.name;"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var bodies []string
+			ts, c := startCalendarServer(&bodies)
+			defer ts.Close()
+
+			from := time.Date(2024, time.October, 1, 0, 0, 0, 0, time.UTC)
+			to := time.Date(2024, time.November, 1, 0, 0, 0, 0, time.UTC)
+
+			cal, err := c.ReleaseDates.Calendar(context.Background(), from, to, test.opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(bodies) < 1 {
+				t.Fatal("got: no requests, want: at least one request")
+			}
+			if !strings.Contains(bodies[0], test.wantFields) {
+				t.Errorf("got: <%v>, want query containing: <%v>", bodies[0], test.wantFields)
+			}
+
+			if len(cal.Groups) != 5 {
+				t.Errorf("got: <%v> groups, want: <%v>", len(cal.Groups), 5)
+			}
+		})
+	}
+}
+
+func TestReleaseDateService_CalendarWindow(t *testing.T) {
+	var bodies []string
+	ts, c := startCalendarServer(&bodies)
+	defer ts.Close()
+
+	now := time.Now()
+	_, err := c.ReleaseDates.Calendar(context.Background(), now, now)
+	if errors.Cause(err) != ErrInvalidWindow {
+		t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), ErrInvalidWindow)
+	}
+
+	if len(bodies) != 0 {
+		t.Errorf("got: <%v> requests, want: <%v>", len(bodies), 0)
+	}
+}
+
+func TestCalendar_WriteICS(t *testing.T) {
+	oct15 := FuzzyDate{Precision: PrecisionDay, Year: 2024, Quarter: 4, Month: time.October, Day: 15}
+	cal := &Calendar{
+		Generated: time.Date(2024, time.September, 1, 12, 30, 0, 0, time.UTC),
+		Groups: []*CalendarGroup{
+			{
+				Date: FuzzyDate{Precision: PrecisionYear, Year: 2024},
+				Entries: []*CalendarEntry{
+					{Game: Reference{ID: 1}, Name: "Vague"},
+				},
+			},
+			{
+				Date: oct15,
+				Entries: []*CalendarEntry{
+					{Game: Reference{ID: 2}, Name: "Zeta; Part 1, Redux", Regions: []RegionCategory{RegionEurope, RegionJapan}},
+					{Game: Reference{ID: 3}, Name: strings.Repeat("Long ", 20)},
+					{Game: Reference{ID: 4}},
+				},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := cal.WriteICS(&buf); err != nil {
+		t.Fatal(err)
+	}
+	ics := buf.String()
+
+	for _, want := range []string{
+		"BEGIN:VCALENDAR\r\nVERSION:2.0\r\n",
+		"UID:igdb-release-2-20241015@" + DefaultUIDDomain + "\r\n",
+		"DTSTAMP:20240901T123000Z\r\n",
+		"DTSTART;VALUE=DATE:20241015\r\nDTEND;VALUE=DATE:20241016\r\n",
+		"SUMMARY:Zeta\\; Part 1\\, Redux\r\n",
+		"DESCRIPTION:Regions: Europe\\, Japan\r\n",
+		"SUMMARY:Game 4\r\n",
+		"END:VCALENDAR\r\n",
+	} {
+		if !strings.Contains(ics, want) {
+			t.Errorf("got: <%q>, want ICS containing: <%q>", ics, want)
+		}
+	}
+
+	if strings.Contains(ics, "Vague") {
+		t.Errorf("got: <%q>, want no events for vague dates", ics)
+	}
+
+	if n := strings.Count(ics, "BEGIN:VEVENT"); n != 3 {
+		t.Errorf("got: <%v> events, want: <%v>", n, 3)
+	}
+
+	for _, line := range strings.Split(ics, "\r\n") {
+		if len(line) > 75 {
+			t.Errorf("got: line of <%v> octets, want at most 75: <%q>", len(line), line)
+		}
+	}
+
+	if !strings.Contains(strings.Replace(ics, "\r\n ", "", -1), "SUMMARY:"+strings.Repeat("Long ", 20)) {
+		t.Errorf("got: <%q>, want unfolded long summary", ics)
+	}
+}
+
+func TestCalendar_WriteICSDomain(t *testing.T) {
+	cal := &Calendar{
+		UIDDomain: "example.com",
+		Groups: []*CalendarGroup{
+			{
+				Date:    FuzzyDate{Precision: PrecisionDay, Year: 2024, Quarter: 4, Month: time.October, Day: 15},
+				Entries: []*CalendarEntry{{Game: Reference{ID: 2}, Name: "Zeta"}},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := cal.WriteICS(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "UID:igdb-release-2-20241015@example.com\r\n"
+	if !strings.Contains(buf.String(), want) {
+		t.Errorf("got: <%q>, want ICS containing: <%q>", buf.String(), want)
+	}
+}
@@ -37,7 +37,7 @@ func TestCharacterService_Get(t *testing.T) {
 	}{
 		{"Valid response", testCharacterGet, 12690, []Option{SetFields("name")}, init[0], nil},
 		{"Invalid ID", testFileEmpty, -1, nil, nil, ErrNegativeID},
-		{"Empty response", testFileEmpty, 12690, nil, nil, errInvalidJSON},
+		{"Empty response", testFileEmpty, 12690, nil, nil, ErrInvalidJSON},
 		{"Invalid option", testFileEmpty, 12690, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
 		{"No results", testFileEmptyArray, 0, nil, nil, ErrNoResults},
 	}
@@ -84,7 +84,7 @@ func TestCharacterService_List(t *testing.T) {
 		{"Valid response", testCharacterList, []int{11079, 799, 11563, 7337, 11576}, []Option{SetLimit(5)}, init, nil},
 		{"Zero IDs", testFileEmpty, nil, nil, nil, ErrEmptyIDs},
 		{"Invalid ID", testFileEmpty, []int{-500}, nil, nil, ErrNegativeID},
-		{"Empty response", testFileEmpty, []int{11079, 799, 11563, 7337, 11576}, nil, nil, errInvalidJSON},
+		{"Empty response", testFileEmpty, []int{11079, 799, 11563, 7337, 11576}, nil, nil, ErrInvalidJSON},
 		{"Invalid option", testFileEmpty, []int{11079, 799, 11563, 7337, 11576}, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
 		{"No results", testFileEmptyArray, []int{0, 9999999}, nil, nil, ErrNoResults},
 	}
@@ -128,7 +128,7 @@ func TestCharacterService_Index(t *testing.T) {
 		wantErr        error
 	}{
 		{"Valid response", testCharacterList, []Option{SetLimit(5)}, init, nil},
-		{"Empty response", testFileEmpty, nil, nil, errInvalidJSON},
+		{"Empty response", testFileEmpty, nil, nil, ErrInvalidJSON},
 		{"Invalid option", testFileEmpty, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
 		{"No results", testFileEmptyArray, nil, nil, ErrNoResults},
 	}
@@ -174,7 +174,7 @@ func TestCharacterService_Search(t *testing.T) {
 	}{
 		{"Valid response", testCharacterSearch, "super", []Option{SetLimit(50)}, init, nil},
 		{"Empty query", testFileEmpty, "", []Option{SetLimit(50)}, nil, ErrEmptyQry},
-		{"Empty response", testFileEmpty, "super", nil, nil, errInvalidJSON},
+		{"Empty response", testFileEmpty, "super", nil, nil, ErrInvalidJSON},
 		{"Invalid option", testFileEmpty, "super", []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
 		{"No results", testFileEmptyArray, "non-existent entry", nil, nil, ErrNoResults},
 	}
@@ -207,7 +207,7 @@ func TestCharacterService_Count(t *testing.T) {
 		wantErr   error
 	}{
 		{"Happy path", `{"count": 100}`, []Option{SetFilter("popularity", OpGreaterThan, "75")}, 100, nil},
-		{"Empty response", "", nil, 0, errInvalidJSON},
+		{"Empty response", "", nil, 0, ErrInvalidJSON},
 		{"Invalid option", "", []Option{SetLimit(-100)}, 0, ErrOutOfRange},
 		{"No results", "[]", nil, 0, ErrNoResults},
 	}
@@ -238,7 +238,7 @@ func TestCharacterService_Fields(t *testing.T) {
 	}{
 		{"Happy path", `["name", "slug", "url"]`, []string{"url", "slug", "name"}, nil},
 		{"Asterisk", `["*"]`, []string{"*"}, nil},
-		{"Empty response", "", nil, errInvalidJSON},
+		{"Empty response", "", nil, ErrInvalidJSON},
 		{"No results", "[]", nil, nil},
 	}
 
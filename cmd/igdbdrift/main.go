@@ -0,0 +1,115 @@
+// Igdbdrift reports the schema drift of every IGDB endpoint: the fields of
+// an endpoint that are missing from the struct representing its objects, the
+// struct fields that are not fields of the endpoint, and the struct fields
+// tagged under another name than the field they represent.
+//
+// The fields of every endpoint are retrieved from its meta endpoint, either
+// live with Twitch credentials or from fixtures recorded with the Recorder of
+// the igdbtest package. The reports are written to the standard output as a
+// JSON array, and igdbdrift exits with status 1 if any drift is found. The
+// endpoints whose fields cannot be retrieved are reported on the standard
+// error as well, and igdbdrift exits with status 2 if there are any, since
+// their drift is unknown.
+//
+// Usage:
+//
+//	igdbdrift -id CLIENT_ID -secret CLIENT_SECRET [-record DIR] [-all]
+//	igdbdrift -replay DIR [-all]
+//
+// The credentials default to the TWITCH_CLIENT_ID and TWITCH_CLIENT_SECRET
+// environment variables.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/Henry-Sarabia/igdb"
+	"github.com/Henry-Sarabia/igdb/igdbtest"
+)
+
+func main() {
+	id := flag.String("id", os.Getenv("TWITCH_CLIENT_ID"), "Twitch client ID")
+	secret := flag.String("secret", os.Getenv("TWITCH_CLIENT_SECRET"), "Twitch client secret")
+	record := flag.String("record", "", "directory to record the meta responses to")
+	replay := flag.String("replay", "", "directory to replay recorded meta responses from")
+	all := flag.Bool("all", false, "report the endpoints without drift as well")
+	flag.Parse()
+
+	log.SetFlags(0)
+	log.SetPrefix("igdbdrift: ")
+
+	var c *igdb.Client
+	switch {
+	case *replay != "":
+		c = igdb.NewClient("", igdbtest.NewRecorder(*replay, igdbtest.ModeReplay).Client(), igdb.WithV4())
+	case *id == "" || *secret == "":
+		log.Fatal("no credentials provided, please run: igdbdrift -id CLIENT_ID -secret CLIENT_SECRET")
+	case *record != "":
+		c = igdb.NewTwitchClient(*id, *secret, igdbtest.NewRecorder(*record, igdbtest.ModeRecord).Client())
+	default:
+		c = igdb.NewTwitchClient(*id, *secret, nil)
+	}
+
+	drift, failed, err := run(context.Background(), c, os.Stdout, os.Stderr, *all)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	switch {
+	case failed:
+		os.Exit(2)
+	case drift:
+		os.Exit(1)
+	}
+}
+
+// report is the JSON report of a single endpoint.
+type report struct {
+	*igdb.DriftReport
+	Error string `json:"error,omitempty"`
+}
+
+// run writes the drift reports of every endpoint of the provided Client to w
+// and the errors of the endpoints whose fields cannot be retrieved to ew. It
+// reports whether any drift was found and whether any endpoint failed. Unless
+// all is set, only the endpoints with drift or an error are written to w.
+func run(ctx context.Context, c *igdb.Client, w, ew io.Writer, all bool) (drift, failed bool, err error) {
+	drs, err := c.DetectDrift(ctx)
+	if err != nil {
+		return false, false, err
+	}
+
+	reports := []report{}
+	for _, dr := range drs {
+		r := report{DriftReport: dr}
+		if dr.Err != nil {
+			r.Error = dr.Err.Error()
+			failed = true
+			if _, err := fmt.Fprintf(ew, "igdbdrift: %s: %v\n", dr.Endpoint, dr.Err); err != nil {
+				return false, false, err
+			}
+		}
+		drift = drift || len(dr.Drift) > 0
+
+		if all || r.Error != "" || len(dr.Drift) > 0 {
+			reports = append(reports, r)
+		}
+	}
+
+	b, err := json.MarshalIndent(reports, "", "  ")
+	if err != nil {
+		return false, false, err
+	}
+
+	if _, err := fmt.Fprintf(w, "%s\n", b); err != nil {
+		return false, false, err
+	}
+
+	return drift, failed, nil
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Henry-Sarabia/igdb"
+	"github.com/Henry-Sarabia/igdb/igdbtest"
+)
+
+// testMeta maps the meta endpoints of the test server to their fields. The
+// other meta endpoints respond with an error.
+var testMeta = map[string]string{
+	"/covers/meta": `["id", "game", "alpha_channel", "animated", "height", "image_id", "url", "width"]`,
+	"/private/test_dummies/meta": `["id", "bool_value", "created_at", "enum_test", "float_value", "game",
+		"integer_array", "integer_value", "name", "new_integer_value", "private", "slug", "string_array",
+		"test_dummies", "test_dummy", "updated_at", "url", "user"]`,
+}
+
+func TestRun(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		meta, ok := testMeta[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, meta)
+	}))
+	defer ts.Close()
+
+	dir, err := ioutil.TempDir("", "igdbdrift")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// The meta responses are recorded first and then replayed,
+	// as with the -record and -replay flags.
+	rec := igdbtest.NewRecorder(dir, igdbtest.ModeRecord)
+	rec.Transport = ts.Client().Transport
+	replay := igdbtest.NewRecorder(dir, igdbtest.ModeReplay)
+
+	var tests = []struct {
+		name       string
+		c          *igdb.Client
+		all        bool
+		wantCovers bool
+	}{
+		{"Record", igdb.NewClient("", rec.Client(), igdb.WithBaseURL(ts.URL)), false, false},
+		{"Replay", igdb.NewClient("", replay.Client(), igdb.WithBaseURL(ts.URL)), false, false},
+		{"Replay all", igdb.NewClient("", replay.Client(), igdb.WithBaseURL(ts.URL)), true, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var buf, errBuf bytes.Buffer
+
+			drift, failed, err := run(context.Background(), test.c, &buf, &errBuf, test.all)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !drift {
+				t.Errorf("got: <%v>, want: <%v>", drift, true)
+			}
+
+			if !failed {
+				t.Errorf("got: <%v>, want: <%v>", failed, true)
+			}
+
+			if !strings.Contains(errBuf.String(), "igdbdrift: games: ") {
+				t.Errorf("got: <%v>, want errors containing: <%v>", errBuf.String(), "igdbdrift: games: ")
+			}
+
+			var reports []struct {
+				Endpoint string
+				Type     string
+				Drift    []igdb.FieldDrift
+				Error    string
+			}
+			if err := json.Unmarshal(buf.Bytes(), &reports); err != nil {
+				t.Fatal(err)
+			}
+
+			found := make(map[string]int)
+			for i, r := range reports {
+				found[r.Endpoint] = i
+			}
+
+			i, ok := found["private/test_dummies"]
+			want := []igdb.FieldDrift{{Kind: igdb.DriftMistagged, Field: "id", GoField: "ID", Tag: "int"}}
+			if !ok || !reflect.DeepEqual(reports[i].Drift, want) {
+				t.Errorf("got: <%v>, want: <%v>", reports[i].Drift, want)
+			}
+
+			if i, ok := found["games"]; !ok || reports[i].Error == "" {
+				t.Errorf("got: <%v>, want report with error", reports[i])
+			}
+
+			if _, ok := found["covers"]; ok != test.wantCovers {
+				t.Errorf("got: <%v>, want: <%v>", ok, test.wantCovers)
+			}
+		})
+	}
+}
+
+func TestRun_Failed(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer ts.Close()
+
+	var buf, errBuf bytes.Buffer
+
+	drift, failed, err := run(context.Background(), igdb.NewClient("", ts.Client(), igdb.WithBaseURL(ts.URL)), &buf, &errBuf, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if drift {
+		t.Errorf("got: <%v>, want: <%v>", drift, false)
+	}
+
+	if !failed {
+		t.Errorf("got: <%v>, want: <%v>", failed, true)
+	}
+
+	if errBuf.Len() == 0 {
+		t.Errorf("got: <%v>, want errors", errBuf.String())
+	}
+}
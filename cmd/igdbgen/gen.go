@@ -0,0 +1,454 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// file is a file produced by igdbgen.
+type file struct {
+	// Path is the path of the file relative to the igdb package.
+	Path string
+	Body []byte
+}
+
+// generate returns every file produced for the provided Endpoint: the
+// object and its service, the String methods of its enums, its tests,
+// and the test data its tests read.
+func generate(e *Endpoint) ([]file, error) {
+	base := strings.ToLower(e.Name)
+
+	obj, err := render(objectTmpl, e)
+	if err != nil {
+		return nil, err
+	}
+
+	test, err := render(testTmpl, e)
+	if err != nil {
+		return nil, err
+	}
+
+	files := []file{
+		{Path: base + ".go", Body: obj},
+		{Path: base + "_test.go", Body: test},
+		{Path: "test_data/" + base + "_get.json", Body: fixture(e, 1, 1)},
+		{Path: "test_data/" + base + "_list.json", Body: fixture(e, 1, 3)},
+	}
+
+	if e.Search {
+		files = append(files, file{Path: "test_data/" + base + "_search.json", Body: fixture(e, 4, 2)})
+	}
+
+	if len(e.Enums) > 0 {
+		str, err := stringer(e.Enums)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file{Path: base + "_string.go", Body: str})
+	}
+
+	return files, nil
+}
+
+// render executes the provided template with the provided Endpoint
+// and formats the result.
+func render(tmpl *template.Template, e *Endpoint) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, e); err != nil {
+		return nil, err
+	}
+
+	b, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("igdbgen: cannot format %s: %v", e.Name, err)
+	}
+
+	return b, nil
+}
+
+// File returns the name of the Endpoint's files without their
+// extension (e.g. "agerating" for AgeRating).
+func (e *Endpoint) File() string {
+	return strings.ToLower(e.Name)
+}
+
+// Private reports whether the Endpoint is a private endpoint.
+func (e *Endpoint) Private() bool {
+	return strings.HasPrefix(e.Path, "private/")
+}
+
+// Iota returns the expression of the first constant of the Enum.
+func (en *Enum) Iota() string {
+	if en.Start == 0 {
+		return "iota"
+	}
+
+	return "iota + " + strconv.Itoa(en.Start)
+}
+
+// fixture returns a JSON array of n sample objects of the provided Endpoint
+// with consecutive IDs starting at the provided ID. Fields of any type
+// other than the types in fieldTypes and enums are left out.
+func fixture(e *Endpoint, id, n int) []byte {
+	enums := make(map[string]*Enum)
+	for _, en := range e.Enums {
+		enums[en.Name] = en
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("[\n")
+
+	for i := 0; i < n; i++ {
+		var fields []string
+		for _, f := range e.Fields {
+			v, ok := sample(f, id+i, enums)
+			if !ok {
+				continue
+			}
+			fields = append(fields, fmt.Sprintf("    %q: %s", f.Name, v))
+		}
+
+		buf.WriteString("  {\n" + strings.Join(fields, ",\n") + "\n  }")
+		if i < n-1 {
+			buf.WriteString(",")
+		}
+		buf.WriteString("\n")
+	}
+
+	buf.WriteString("]\n")
+	return buf.Bytes()
+}
+
+// sample returns the JSON value of the provided Field in the sample object
+// with the provided ID, or false if the Field has no sample value.
+func sample(f *Field, id int, enums map[string]*Enum) (string, bool) {
+	if f.Name == "id" {
+		return strconv.Itoa(id), true
+	}
+
+	switch f.Type {
+	case "int", "reference":
+		return strconv.Itoa(id * 10), true
+	case "float":
+		return strconv.Itoa(id) + ".5", true
+	case "bool":
+		return strconv.FormatBool(id%2 == 1), true
+	case "string":
+		return strconv.Quote(f.Name + " " + strconv.Itoa(id)), true
+	case "timestamp":
+		return strconv.Itoa(1546300800 + id*86400), true
+	case "references", "ints", "tags":
+		return fmt.Sprintf("[%d, %d]", id*10, id*10+1), true
+	case "strings":
+		return fmt.Sprintf("[%q, %q]", f.Name+" "+strconv.Itoa(id), f.Name+" "+strconv.Itoa(id+1)), true
+	case "enum":
+		if en, ok := enums[f.Enum]; ok {
+			return strconv.Itoa(en.Start + (id-1)%len(en.Values)), true
+		}
+		return "", false
+	}
+
+	return "", false
+}
+
+var funcs = template.FuncMap{
+	"quote": strconv.Quote,
+}
+
+var objectTmpl = template.Must(template.New("object").Funcs(funcs).Parse(`package igdb
+
+// {{.Name}} {{.Doc}}
+// For more information visit: https://api-docs.igdb.com/#{{.Anchor}}
+type {{.Name}} struct {
+{{- range .Embed}}
+	{{.}}
+{{- end}}
+{{- range .Fields}}
+	{{.GoName}} {{.GoType}} ` + "`json:{{quote .Name}}`" + `
+{{- end}}
+}
+{{range .Enums}}
+// {{.Name}} {{.Doc}}
+type {{.Name}} int
+
+// Expected {{.Name}} enums from the IGDB.
+const (
+{{- $en := .}}
+{{- range $i, $v := .Values}}
+	{{$v}}{{if eq $i 0}} {{$en.Name}} = {{$en.Iota}}{{end}}
+{{- end}}
+)
+{{end}}
+// {{.Name}}Service handles all the API calls for the IGDB {{.Name}} endpoint.
+type {{.Name}}Service struct {
+	{{if .Search}}Searchable{{end}}Service[{{.Name}}]
+}
+`))
+
+var testTmpl = template.Must(template.New("test").Parse(`package igdb
+
+import (
+	"encoding/json"
+	"github.com/pkg/errors"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+const (
+	test{{.Name}}Get  string = "test_data/{{.File}}_get.json"
+	test{{.Name}}List string = "test_data/{{.File}}_list.json"
+{{- if .Search}}
+	test{{.Name}}Search string = "test_data/{{.File}}_search.json"
+{{- end}}
+)
+
+func Test{{.Name}}Service_Get(t *testing.T) {
+	f, err := ioutil.ReadFile(test{{.Name}}Get)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*{{.Name}}, 1)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name    string
+		file    string
+		id      int
+		opts    []Option
+		want{{.Name}} *{{.Name}}
+		wantErr error
+	}{
+		{"Valid response", test{{.Name}}Get, 1, []Option{SetFields("name")}, init[0], nil},
+		{"Invalid ID", testFileEmpty, -1, nil, nil, ErrNegativeID},
+		{"Empty response", testFileEmpty, 1, nil, nil, ErrInvalidJSON},
+		{"Invalid option", testFileEmpty, 1, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, 0, nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			res, err := c.{{.Field}}.Get(test.id, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(res, test.want{{.Name}}) {
+				t.Errorf("got: <%v>, \nwant: <%v>", res, test.want{{.Name}})
+			}
+		})
+	}
+}
+
+func Test{{.Name}}Service_List(t *testing.T) {
+	f, err := ioutil.ReadFile(test{{.Name}}List)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*{{.Name}}, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name    string
+		file    string
+		ids     []int
+		opts    []Option
+		want{{.Plural}} []*{{.Name}}
+		wantErr error
+	}{
+		{"Valid response", test{{.Name}}List, []int{1, 2, 3}, []Option{SetLimit(5)}, init, nil},
+		{"Zero IDs", testFileEmpty, nil, nil, nil, ErrEmptyIDs},
+		{"Invalid ID", testFileEmpty, []int{-500}, nil, nil, ErrNegativeID},
+		{"Empty response", testFileEmpty, []int{1, 2, 3}, nil, nil, ErrInvalidJSON},
+		{"Invalid option", testFileEmpty, []int{1, 2, 3}, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, []int{0, 9999999}, nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			res, err := c.{{.Field}}.List(test.ids, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(res, test.want{{.Plural}}) {
+				t.Errorf("got: <%v>, \nwant: <%v>", res, test.want{{.Plural}})
+			}
+		})
+	}
+}
+
+func Test{{.Name}}Service_Index(t *testing.T) {
+	f, err := ioutil.ReadFile(test{{.Name}}List)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*{{.Name}}, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		file    string
+		opts    []Option
+		want{{.Plural}} []*{{.Name}}
+		wantErr error
+	}{
+		{"Valid response", test{{.Name}}List, []Option{SetLimit(5)}, init, nil},
+		{"Empty response", testFileEmpty, nil, nil, ErrInvalidJSON},
+		{"Invalid option", testFileEmpty, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			res, err := c.{{.Field}}.Index(test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(res, test.want{{.Plural}}) {
+				t.Errorf("got: <%v>, \nwant: <%v>", res, test.want{{.Plural}})
+			}
+		})
+	}
+}
+{{if .Search}}
+func Test{{.Name}}Service_Search(t *testing.T) {
+	f, err := ioutil.ReadFile(test{{.Name}}Search)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*{{.Name}}, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name    string
+		file    string
+		qry     string
+		opts    []Option
+		want{{.Plural}} []*{{.Name}}
+		wantErr error
+	}{
+		{"Valid response", test{{.Name}}Search, "sample", []Option{SetLimit(5)}, init, nil},
+		{"Empty query", testFileEmpty, "", []Option{SetLimit(5)}, nil, ErrEmptyQry},
+		{"Empty response", testFileEmpty, "sample", nil, nil, ErrInvalidJSON},
+		{"Invalid option", testFileEmpty, "sample", []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, "non-existent entry", nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			res, err := c.{{.Field}}.Search(test.qry, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(res, test.want{{.Plural}}) {
+				t.Errorf("got: <%v>, \nwant: <%v>", res, test.want{{.Plural}})
+			}
+		})
+	}
+}
+{{end}}
+func Test{{.Name}}Service_Count(t *testing.T) {
+	var tests = []struct {
+		name      string
+		resp      string
+		opts      []Option
+		wantCount int
+		wantErr   error
+	}{
+		{"Happy path", ` + "`" + `{"count": 100}` + "`" + `, []Option{SetFilter("popularity", OpGreaterThan, "75")}, 100, nil},
+		{"Empty response", "", nil, 0, ErrInvalidJSON},
+		{"Invalid option", "", []Option{SetLimit(-100)}, 0, ErrOutOfRange},
+		{"No results", "[]", nil, 0, ErrNoResults},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerString(http.StatusOK, test.resp)
+			defer ts.Close()
+
+			count, err := c.{{.Field}}.Count(test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if count != test.wantCount {
+				t.Fatalf("got: <%v>, want: <%v>", count, test.wantCount)
+			}
+		})
+	}
+}
+
+func Test{{.Name}}Service_Fields(t *testing.T) {
+	var tests = []struct {
+		name       string
+		resp       string
+		wantFields []string
+		wantErr    error
+	}{
+		{"Happy path", ` + "`" + `["name", "slug", "url"]` + "`" + `, []string{"url", "slug", "name"}, nil},
+		{"Asterisk", ` + "`" + `["*"]` + "`" + `, []string{"*"}, nil},
+		{"Empty response", "", nil, ErrInvalidJSON},
+		{"No results", "[]", nil, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerString(http.StatusOK, test.resp)
+			defer ts.Close()
+
+			fields, err := c.{{.Field}}.Fields()
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !equalSlice(fields, test.wantFields) {
+				t.Fatalf("got: <%v>, want: <%v>", fields, test.wantFields)
+			}
+		})
+	}
+}
+`))
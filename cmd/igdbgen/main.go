@@ -0,0 +1,119 @@
+// Igdbgen generates the code of IGDB endpoints from a declarative schema.
+//
+// For every endpoint declared in the schema, igdbgen writes the object, its
+// enums, and its service, the String methods of its enums, its tests, and the
+// test data its tests read. Every operation of the service, including its
+// iterators and loader, comes from the generic Service it embeds. It then
+// registers the endpoint in the igdb package: its Endpoint constant in
+// endpoints.go, and its service field and initialization in igdb.go. Run go
+// generate afterwards to update the interfaces of the services and their mocks.
+//
+// The object, its tests, and its test data are a starting point meant to be
+// edited like any other file of the package, so only the String methods,
+// written to the <endpoint>_string.go file, are marked as generated.
+//
+// Usage:
+//
+//	go run ./cmd/igdbgen -schema endpoints.json [-dir .] [-force]
+//
+// The schema is a JSON file declaring the endpoints, their fields, and their
+// enums. See the Schema type for every available setting. For example:
+//
+//	{
+//	  "endpoints": [
+//	    {
+//	      "name": "Cover",
+//	      "path": "covers",
+//	      "doc": "represents the cover art for a specific video game.",
+//	      "embed": ["Image"],
+//	      "fields": [
+//	        {"name": "game", "type": "reference"}
+//	      ]
+//	    }
+//	  ]
+//	}
+//
+// Existing files are never overwritten unless the -force flag is set.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+)
+
+func main() {
+	schema := flag.String("schema", "", "path of the JSON schema of the endpoints to generate")
+	dir := flag.String("dir", ".", "directory of the igdb package")
+	force := flag.Bool("force", false, "overwrite existing files")
+	flag.Parse()
+
+	log.SetFlags(0)
+	log.SetPrefix("igdbgen: ")
+
+	if *schema == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	s, err := readSchema(*schema)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	written, err := run(s, *dir, *force)
+	for _, path := range written {
+		fmt.Println("igdbgen: wrote", path)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println("igdbgen: run go generate to update the service interfaces and mocks")
+}
+
+// run generates every file of every endpoint of the provided Schema into
+// the provided directory and registers the endpoints. It returns the paths
+// of the files written. Unless force is set, nothing is written if any of
+// the files already exists.
+func run(s *Schema, dir string, force bool) ([]string, error) {
+	var files []file
+	for _, e := range s.Endpoints {
+		fs, err := generate(e)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, fs...)
+	}
+
+	if !force {
+		for _, f := range files {
+			if _, err := os.Stat(filepath.Join(dir, f.Path)); err == nil {
+				return nil, fmt.Errorf("%s already exists, use -force to overwrite it", f.Path)
+			}
+		}
+	}
+
+	var written []string
+	for _, f := range files {
+		path := filepath.Join(dir, f.Path)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return written, err
+		}
+		if err := ioutil.WriteFile(path, f.Body, 0644); err != nil {
+			return written, err
+		}
+		written = append(written, f.Path)
+	}
+
+	for _, e := range s.Endpoints {
+		if err := register(dir, e); err != nil {
+			return written, err
+		}
+	}
+
+	return written, nil
+}
@@ -0,0 +1,349 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// root is the directory of the igdb package.
+var root = filepath.Join("..", "..")
+
+func TestGoName(t *testing.T) {
+	var tests = []struct {
+		in   string
+		want string
+	}{
+		{"name", "Name"},
+		{"image_id", "ImageID"},
+		{"pulse_url", "PulseURL"},
+		{"game_ids", "GameIDs"},
+		{"alpha__channel", "AlphaChannel"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.in, func(t *testing.T) {
+			if got := goName(test.in); got != test.want {
+				t.Errorf("got: <%v>, want: <%v>", got, test.want)
+			}
+		})
+	}
+}
+
+func TestSnake(t *testing.T) {
+	var tests = []struct {
+		in   string
+		want string
+	}{
+		{"Cover", "cover"},
+		{"AgeRating", "age_rating"},
+		{"PulseURL", "pulse_url"},
+		{"URLSource", "url_source"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.in, func(t *testing.T) {
+			if got := snake(test.in); got != test.want {
+				t.Errorf("got: <%v>, want: <%v>", got, test.want)
+			}
+		})
+	}
+}
+
+func TestPlural(t *testing.T) {
+	var tests = []struct {
+		in   string
+		want string
+	}{
+		{"Cover", "Covers"},
+		{"Company", "Companies"},
+		{"PlayDay", "PlayDays"},
+		{"Status", "Statuses"},
+		{"Box", "Boxes"},
+		{"Match", "Matches"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.in, func(t *testing.T) {
+			if got := plural(test.in); got != test.want {
+				t.Errorf("got: <%v>, want: <%v>", got, test.want)
+			}
+		})
+	}
+}
+
+func TestParseSchema(t *testing.T) {
+	var tests = []struct {
+		name    string
+		schema  string
+		wantErr string
+	}{
+		{"Valid schema", `{"endpoints": [{"name": "Cover", "path": "covers"}]}`, ""},
+		{"Invalid JSON", `{`, "invalid schema"},
+		{"No endpoints", `{"endpoints": []}`, "no endpoints"},
+		{"Invalid name", `{"endpoints": [{"name": "cover", "path": "covers"}]}`, "invalid endpoint name"},
+		{"Invalid path", `{"endpoints": [{"name": "Cover", "path": "v4/covers"}]}`, "invalid path"},
+		{"Duplicate endpoint", `{"endpoints": [{"name": "Cover", "path": "covers"}, {"name": "Cover", "path": "covers"}]}`, "declared twice"},
+		{"Duplicate field", `{"endpoints": [{"name": "Cover", "path": "covers", "fields": [{"name": "game", "type": "int"}, {"name": "game", "type": "int"}]}]}`, "declared twice"},
+		{"Missing type", `{"endpoints": [{"name": "Cover", "path": "covers", "fields": [{"name": "game"}]}]}`, "missing a name or type"},
+		{"Missing enum", `{"endpoints": [{"name": "Cover", "path": "covers", "fields": [{"name": "kind", "type": "enum"}]}]}`, "missing its enum"},
+		{"Empty enum", `{"endpoints": [{"name": "Cover", "path": "covers", "enums": [{"name": "CoverKind"}]}]}`, "invalid enum"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := parseSchema([]byte(test.schema))
+			if test.wantErr == "" && err != nil || test.wantErr != "" && (err == nil || !strings.Contains(err.Error(), test.wantErr)) {
+				t.Errorf("got: <%v>, want error containing: <%v>", err, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseSchema_Defaults(t *testing.T) {
+	s, err := parseSchema([]byte(`{"endpoints": [{"name": "AgeRatingCategory", "path": "/private/age_rating_categories/", "fields": [{"name": "checksum", "type": "string"}]}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	e := s.Endpoints[0]
+	got := []string{e.Plural, e.Field, e.Path, e.Anchor, e.Fields[0].GoName, e.Fields[1].GoName}
+	want := []string{"AgeRatingCategories", "AgeRatingCategories", "private/age_rating_categories", "age-rating-category", "ID", "Checksum"}
+	if strings.Join(got, " ") != strings.Join(want, " ") {
+		t.Errorf("got: <%v>, want: <%v>", got, want)
+	}
+
+	if !e.Private() {
+		t.Errorf("got: <%v>, want: <%v>", e.Private(), true)
+	}
+}
+
+func TestStringer(t *testing.T) {
+	want, err := ioutil.ReadFile(filepath.Join(root, "achievementrank_string.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := stringer([]*Enum{
+		{Name: "AchievementRank", Values: []string{"RankBronze", "RankSilver", "RankGold", "RankPlatinum"}, Start: 1},
+		{Name: "AchievementCategory", Values: []string{"AchievementPlaystation", "AchievementXbox", "AchievementSteam"}, Start: 1},
+		{Name: "AchievementLanguage", Values: []string{"LanguageEurope", "LanguageNorthAmerica", "LanguageAustralia", "LanguageNewZealand", "LanguageJapan", "LanguageChina", "LanguageAsia", "LanguageWorldwide", "LanguageHongKong", "LanguageSouthKorea"}, Start: 1},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Only the headers of the files differ.
+	got = got[bytes.IndexByte(got, '\n'):]
+	want = want[bytes.IndexByte(want, '\n'):]
+	if !bytes.Equal(got, want) {
+		t.Errorf("got: <%s>, want: <%s>", got, want)
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	s, err := readSchema(filepath.Join("testdata", "schema.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := generate(s.Endpoints[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	found := make(map[string]string)
+	for _, f := range files {
+		found[f.Path] = string(f.Body)
+	}
+
+	obj := found["sample.go"]
+	if !strings.HasPrefix(obj, "package igdb\n") {
+		t.Errorf("got: <%v>, want prefix: <%v>", obj, "package igdb")
+	}
+	for _, unwanted := range []string{"DO NOT EDIT", "import", "func "} {
+		if strings.Contains(obj, unwanted) {
+			t.Errorf("got: <%v>, want no: <%v>", obj, unwanted)
+		}
+	}
+	want := "type SampleService struct {\n\tSearchableService[Sample]\n}\n"
+	if !strings.HasSuffix(obj, want) {
+		t.Errorf("got: <%v>, want suffix: <%v>", obj, want)
+	}
+
+	if str := found["sample_string.go"]; !strings.HasPrefix(str, header) {
+		t.Errorf("got: <%v>, want prefix: <%v>", str, header)
+	}
+}
+
+func TestRegister(t *testing.T) {
+	var tests = []struct {
+		name  string
+		file  string
+		edit  func([]byte, *Endpoint) ([]byte, error)
+		e     *Endpoint
+		wants []string
+	}{
+		{
+			"Public endpoint",
+			"endpoints.go",
+			registerEndpoint,
+			&Endpoint{Name: "Region", Path: "regions"},
+			[]string{"\tEndpointRegion endpoint = \"regions/\"\n\tEndpointReleaseDate endpoint"},
+		},
+		{
+			"Private endpoint",
+			"endpoints.go",
+			registerEndpoint,
+			&Endpoint{Name: "Achievement", Path: "private/achievements"},
+			[]string{"const (\n\tEndpointAchievement endpoint = \"private/achievements/\"\n\tEndpointCredit "},
+		},
+		{
+			"Public service",
+			"igdb.go",
+			registerService,
+			&Endpoint{Name: "Region", Field: "Regions", Plural: "Regions", Path: "regions", Search: true},
+			[]string{
+				"\tRegions *RegionService\n\tReleaseDates ",
+				"\tc.Regions = &RegionService{newSearchableService[Region](c, EndpointRegion, \"Regions\")}\n\tc.ReleaseDates = ",
+			},
+		},
+		{
+			"Private service",
+			"igdb.go",
+			registerService,
+			&Endpoint{Name: "Wishlist", Field: "Wishlists", Plural: "Wishlists", Path: "private/wishlists"},
+			[]string{
+				"\tTestDummies *TestDummyService\n\tWishlists *WishlistService\n}",
+				"\tc.Wishlists = &WishlistService{newService[Wishlist](c, EndpointWishlist, \"Wishlists\")}\n\n\tfor _, opt",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			src, err := ioutil.ReadFile(filepath.Join(root, test.file))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := test.edit(src, test.e)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, want := range test.wants {
+				if !strings.Contains(squash(got), want) {
+					t.Errorf("got: <%s>, want output containing: <%q>", got, want)
+				}
+			}
+
+			again, err := test.edit(got, test.e)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(again, got) {
+				t.Errorf("got: changes when registering twice, want: no changes")
+			}
+		})
+	}
+}
+
+// squash returns the provided source with every run of spaces
+// replaced by a single space, ignoring the alignment of gofmt.
+func squash(src []byte) string {
+	return spacesRE.ReplaceAllString(string(src), " ")
+}
+
+var spacesRE = regexp.MustCompile(` +`)
+
+func TestRegister_Existing(t *testing.T) {
+	for file, edit := range map[string]func([]byte, *Endpoint) ([]byte, error){"endpoints.go": registerEndpoint, "igdb.go": registerService} {
+		t.Run(file, func(t *testing.T) {
+			src, err := ioutil.ReadFile(filepath.Join(root, file))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := edit(src, &Endpoint{Name: "Cover", Field: "Covers", Plural: "Covers", Path: "covers"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, src) {
+				t.Errorf("got: changes for a registered endpoint, want: no changes")
+			}
+		})
+	}
+}
+
+// TestRun generates the endpoints of the test schema into a copy of the
+// igdb package and runs the generated tests there.
+func TestRun(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping generation into a copy of the igdb package in short mode")
+	}
+
+	dir, err := ioutil.TempDir("", "igdbgen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	paths, err := filepath.Glob(filepath.Join(root, "*.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := filepath.Glob(filepath.Join(root, "test_data", "*"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	paths = append(paths, filepath.Join(root, "go.mod"), filepath.Join(root, "go.sum"))
+
+	if err := os.Mkdir(filepath.Join(dir, "test_data"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range append(paths, data...) {
+		b, err := ioutil.ReadFile(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rel, _ := filepath.Rel(root, p)
+		if err := ioutil.WriteFile(filepath.Join(dir, rel), b, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	s, err := readSchema(filepath.Join("testdata", "schema.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	written, err := run(s, dir, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(written) != 10 {
+		t.Errorf("got: <%v> files, want: <%v>", len(written), 10)
+	}
+
+	if _, err := run(s, dir, false); err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Errorf("got: <%v>, want error containing: <%v>", err, "already exists")
+	}
+	if _, err := run(s, dir, true); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, args := range [][]string{{"vet", "."}, {"test", "-run", "Sample|Client_Services", "."}} {
+		cmd := exec.Command("go", args...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Errorf("go %s: %v\n%s", strings.Join(args, " "), err, out)
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package main
+
+import (
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+var (
+	// endpointRE matches an Endpoint constant in endpoints.go.
+	endpointRE = regexp.MustCompile(`^\tEndpoint(\w+)\s+endpoint = `)
+	// fieldRE matches a service field of the Client in igdb.go.
+	fieldRE = regexp.MustCompile(`^\t\w+\s+\*(\w+)Service$`)
+	// initRE matches the initialization of a service in NewClient.
+	initRE = regexp.MustCompile(`^\tc\.\w+ = &(\w+)Service\{`)
+)
+
+// register registers the provided Endpoint in the igdb package in the
+// provided directory: its Endpoint constant in endpoints.go, and its
+// service field and initialization in igdb.go. Registering an Endpoint
+// twice has no effect.
+func register(dir string, e *Endpoint) error {
+	if err := rewrite(filepath.Join(dir, "endpoints.go"), e, registerEndpoint); err != nil {
+		return err
+	}
+
+	return rewrite(filepath.Join(dir, "igdb.go"), e, registerService)
+}
+
+// rewrite applies the provided edit to the file with the provided path.
+func rewrite(path string, e *Endpoint, edit func([]byte, *Endpoint) ([]byte, error)) error {
+	src, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	out, err := edit(src, e)
+	if err != nil {
+		return fmt.Errorf("igdbgen: cannot register %s in %s: %v", e.Name, path, err)
+	}
+
+	return ioutil.WriteFile(path, out, 0644)
+}
+
+// registerEndpoint adds the Endpoint constant of the provided Endpoint to
+// the public or private constants of the provided endpoints.go source.
+func registerEndpoint(src []byte, e *Endpoint) ([]byte, error) {
+	marker := "// Public IGDB API endpoints"
+	if e.Private() {
+		marker = "// Private IGDB API endpoints"
+	}
+
+	lines := strings.Split(string(src), "\n")
+	start := indexOf(lines, marker, 0)
+	if start < 0 || start+1 >= len(lines) || lines[start+1] != "const (" {
+		return nil, fmt.Errorf("cannot find %q", marker)
+	}
+	start += 2
+
+	line := fmt.Sprintf("\tEndpoint%s endpoint = %q", e.Name, e.Path+"/")
+	lines, err := insert(lines, start, blockEnd(lines, start, ")"), endpointRE, e.Name, line)
+	if err != nil {
+		return nil, err
+	}
+
+	return format.Source([]byte(strings.Join(lines, "\n")))
+}
+
+// registerService adds the service field of the provided Endpoint to the
+// public or private services of the Client in the provided igdb.go source,
+// and its initialization to NewClient.
+func registerService(src []byte, e *Endpoint) ([]byte, error) {
+	marker := "\t// Services"
+	if e.Private() {
+		marker = "\t// Private Services"
+	}
+
+	lines := strings.Split(string(src), "\n")
+	start := indexOf(lines, marker, 0)
+	if start < 0 {
+		return nil, fmt.Errorf("cannot find %q", strings.TrimSpace(marker))
+	}
+	start++
+
+	field := fmt.Sprintf("\t%s *%sService", e.Field, e.Name)
+	lines, err := insert(lines, start, blockEnd(lines, start, ""), fieldRE, e.Name, field)
+	if err != nil {
+		return nil, err
+	}
+
+	// The services of NewClient are initialized in two blocks,
+	// the public ones followed by the private ones.
+	start = indexOf(lines, "func NewClient(", 0)
+	if start < 0 {
+		return nil, fmt.Errorf("cannot find NewClient")
+	}
+	for i := 0; ; i++ {
+		for start < len(lines) && !initRE.MatchString(lines[start]) {
+			start++
+		}
+		if start == len(lines) {
+			return nil, fmt.Errorf("cannot find the services initialized in NewClient")
+		}
+		if i == 1 || !e.Private() {
+			break
+		}
+		start = blockEnd(lines, start, "")
+	}
+
+	ctor := "newService"
+	if e.Search {
+		ctor = "newSearchableService"
+	}
+	init := fmt.Sprintf("\tc.%s = &%sService{%s[%s](c, Endpoint%s, %q)}", e.Field, e.Name, ctor, e.Name, e.Name, e.Plural)
+	lines, err = insert(lines, start, blockEnd(lines, start, ""), initRE, e.Name, init)
+	if err != nil {
+		return nil, err
+	}
+
+	return format.Source([]byte(strings.Join(lines, "\n")))
+}
+
+// insert inserts the provided line into lines[start:end], in order of the
+// keys captured by the provided regexp, unless a line with the same key is
+// already present. The lines of the block must all match the regexp.
+func insert(lines []string, start, end int, re *regexp.Regexp, key, line string) ([]string, error) {
+	at := end
+	for i := start; i < end; i++ {
+		m := re.FindStringSubmatch(lines[i])
+		if m == nil {
+			return nil, fmt.Errorf("unexpected line %q", strings.TrimSpace(lines[i]))
+		}
+
+		if m[1] == key {
+			return lines, nil
+		}
+		if m[1] > key && at == end {
+			at = i
+		}
+	}
+
+	out := make([]string, 0, len(lines)+1)
+	out = append(out, lines[:at]...)
+	out = append(out, line)
+	return append(out, lines[at:]...), nil
+}
+
+// indexOf returns the index of the first line from the provided index that
+// starts with the provided prefix, or -1 if there is none.
+func indexOf(lines []string, prefix string, from int) int {
+	for i := from; i < len(lines); i++ {
+		if strings.HasPrefix(lines[i], prefix) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// blockEnd returns the index of the line ending the block starting at the
+// provided index: the first line equal to the provided terminator, or the
+// first line that is empty or closes a brace if the terminator is empty.
+func blockEnd(lines []string, start int, term string) int {
+	for i := start; i < len(lines); i++ {
+		l := strings.TrimSpace(lines[i])
+		if term != "" && l == term || term == "" && (l == "" || l == "}") {
+			return i
+		}
+	}
+
+	return len(lines)
+}
@@ -0,0 +1,284 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// Schema declares the IGDB endpoints to generate.
+type Schema struct {
+	Endpoints []*Endpoint `json:"endpoints"`
+}
+
+// Endpoint declares a single IGDB endpoint and the object it serves.
+type Endpoint struct {
+	// Name is the Go type of the object (e.g. "Cover").
+	Name string `json:"name"`
+	// Plural names several objects in docs and errors. If empty, it is
+	// derived from Name (e.g. "Covers" or "Companies").
+	Plural string `json:"plural"`
+	// Field is the name of the service field of the Client. If empty,
+	// Plural is used instead.
+	Field string `json:"field"`
+	// Path is the path of the endpoint (e.g. "covers" or "private/people").
+	// Endpoints under "private/" are registered as private endpoints.
+	Path string `json:"path"`
+	// Doc completes the doc comment of the object after its name
+	// (e.g. "represents the cover art for a specific video game.").
+	Doc string `json:"doc"`
+	// Anchor is the anchor of the endpoint in the IGDB API documentation. If
+	// empty, it is derived from Name (e.g. "cover" or "age-rating").
+	Anchor string `json:"anchor"`
+	// Search is true if the endpoint can be searched.
+	Search bool `json:"search"`
+	// Embed lists the types embedded in the object (e.g. "Image").
+	Embed []string `json:"embed"`
+	// Fields lists the fields of the object in order. The id field is
+	// added first if it is not declared.
+	Fields []*Field `json:"fields"`
+	// Enums lists the enums declared alongside the object.
+	Enums []*Enum `json:"enums"`
+}
+
+// Field declares a single field of an object.
+type Field struct {
+	// Name is the JSON name of the field (e.g. "image_id").
+	Name string `json:"name"`
+	// GoName is the Go name of the field. If empty, it is derived
+	// from Name (e.g. "ImageID").
+	GoName string `json:"go_name"`
+	// Type is the type of the field: one of the types in fieldTypes,
+	// "enum" along with Enum, or any other Go type, used as is.
+	Type string `json:"type"`
+	// Enum is the enum type of a field of type "enum".
+	Enum string `json:"enum"`
+}
+
+// Enum declares an enum type and its values.
+type Enum struct {
+	// Name is the Go type of the enum (e.g. "AchievementRank").
+	Name string `json:"name"`
+	// Doc completes the doc comment of the enum after its name
+	// (e.g. "specifies an achievement's rank.").
+	Doc string `json:"doc"`
+	// Values lists the names of the constants of the enum in order.
+	Values []string `json:"values"`
+	// Start is the value of the first constant.
+	Start int `json:"start"`
+}
+
+// fieldTypes maps the types of fields to their Go types.
+var fieldTypes = map[string]string{
+	"int":        "int",
+	"float":      "float64",
+	"bool":       "bool",
+	"string":     "string",
+	"timestamp":  "Timestamp",
+	"reference":  "Reference",
+	"references": "References",
+	"ints":       "[]int",
+	"strings":    "[]string",
+	"tags":       "[]Tag",
+}
+
+// identRE matches an exported Go identifier.
+var identRE = regexp.MustCompile(`^[A-Z][A-Za-z0-9]*$`)
+
+// pathRE matches the path of an endpoint.
+var pathRE = regexp.MustCompile(`^(private/)?[a-z][a-z0-9_]*$`)
+
+// readSchema reads and validates the Schema in the provided JSON file.
+func readSchema(path string) (*Schema, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseSchema(b)
+}
+
+// parseSchema parses and validates the provided JSON Schema, filling in
+// every derived name.
+func parseSchema(b []byte) (*Schema, error) {
+	var s Schema
+	if err := json.Unmarshal(b, &s); err != nil {
+		return nil, fmt.Errorf("igdbgen: invalid schema: %v", err)
+	}
+
+	if len(s.Endpoints) == 0 {
+		return nil, fmt.Errorf("igdbgen: schema declares no endpoints")
+	}
+
+	names := make(map[string]bool)
+	for _, e := range s.Endpoints {
+		if err := e.normalize(); err != nil {
+			return nil, err
+		}
+
+		for _, n := range append([]string{e.Name}, e.enumNames()...) {
+			if names[n] {
+				return nil, fmt.Errorf("igdbgen: type %s is declared twice", n)
+			}
+			names[n] = true
+		}
+	}
+
+	return &s, nil
+}
+
+// normalize validates the Endpoint and fills in its derived names.
+func (e *Endpoint) normalize() error {
+	if !identRE.MatchString(e.Name) {
+		return fmt.Errorf("igdbgen: invalid endpoint name %q", e.Name)
+	}
+
+	e.Path = strings.Trim(e.Path, "/")
+	if !pathRE.MatchString(e.Path) {
+		return fmt.Errorf("igdbgen: invalid path %q of %s", e.Path, e.Name)
+	}
+
+	if e.Plural == "" {
+		e.Plural = plural(e.Name)
+	}
+	if e.Field == "" {
+		e.Field = e.Plural
+	}
+	if e.Anchor == "" {
+		e.Anchor = strings.Replace(snake(e.Name), "_", "-", -1)
+	}
+	if e.Doc == "" {
+		e.Doc = "represents an IGDB " + e.Name + " object."
+	}
+
+	for _, en := range e.Enums {
+		if !identRE.MatchString(en.Name) || len(en.Values) == 0 {
+			return fmt.Errorf("igdbgen: invalid enum %q of %s", en.Name, e.Name)
+		}
+		for _, v := range en.Values {
+			if !identRE.MatchString(v) {
+				return fmt.Errorf("igdbgen: invalid value %q of enum %s", v, en.Name)
+			}
+		}
+		if en.Doc == "" {
+			en.Doc = "specifies the " + strings.TrimPrefix(en.Name, e.Name) + " of a " + e.Name + "."
+		}
+	}
+
+	hasID := false
+	seen := make(map[string]bool)
+	for _, f := range e.Fields {
+		if f.Name == "" || f.Type == "" {
+			return fmt.Errorf("igdbgen: field of %s is missing a name or type", e.Name)
+		}
+		if f.GoName == "" {
+			f.GoName = goName(f.Name)
+		}
+		if seen[f.GoName] {
+			return fmt.Errorf("igdbgen: field %s of %s is declared twice", f.GoName, e.Name)
+		}
+		seen[f.GoName] = true
+
+		if f.Type == "enum" && f.Enum == "" {
+			return fmt.Errorf("igdbgen: enum field %s of %s is missing its enum", f.Name, e.Name)
+		}
+		hasID = hasID || f.Name == "id"
+	}
+
+	if !hasID {
+		e.Fields = append([]*Field{{Name: "id", GoName: "ID", Type: "int"}}, e.Fields...)
+	}
+
+	return nil
+}
+
+// enumNames returns the names of the enums of the Endpoint.
+func (e *Endpoint) enumNames() []string {
+	var names []string
+	for _, en := range e.Enums {
+		names = append(names, en.Name)
+	}
+
+	return names
+}
+
+// GoType returns the Go type of the Field.
+func (f *Field) GoType() string {
+	if f.Type == "enum" {
+		return f.Enum
+	}
+
+	if t, ok := fieldTypes[f.Type]; ok {
+		return t
+	}
+
+	return f.Type
+}
+
+// initialisms are the words written in upper case in Go names.
+var initialisms = map[string]bool{
+	"api": true, "id": true, "ids": true, "json": true,
+	"url": true, "urls": true, "uuid": true, "html": true,
+}
+
+// goName returns the Go name of the provided JSON name
+// (e.g. "image_id" becomes "ImageID").
+func goName(name string) string {
+	var b strings.Builder
+	for _, word := range strings.Split(name, "_") {
+		if word == "" {
+			continue
+		}
+
+		if initialisms[word] {
+			if strings.HasSuffix(word, "s") && len(word) > 2 {
+				b.WriteString(strings.ToUpper(word[:len(word)-1]) + "s")
+			} else {
+				b.WriteString(strings.ToUpper(word))
+			}
+			continue
+		}
+
+		r := []rune(word)
+		r[0] = unicode.ToUpper(r[0])
+		b.WriteString(string(r))
+	}
+
+	return b.String()
+}
+
+// snake returns the provided Go name in snake case
+// (e.g. "PulseURL" becomes "pulse_url").
+func snake(name string) string {
+	r := []rune(name)
+
+	var b strings.Builder
+	for i, c := range r {
+		if unicode.IsUpper(c) && i > 0 {
+			prevLower := unicode.IsLower(r[i-1])
+			nextLower := i+1 < len(r) && unicode.IsLower(r[i+1])
+			if prevLower || (nextLower && unicode.IsUpper(r[i-1])) {
+				b.WriteByte('_')
+			}
+		}
+		b.WriteRune(unicode.ToLower(c))
+	}
+
+	return b.String()
+}
+
+// plural returns the plural of the provided name
+// (e.g. "Company" becomes "Companies").
+func plural(name string) string {
+	switch {
+	case strings.HasSuffix(name, "y") && len(name) > 1 && !strings.ContainsAny(name[len(name)-2:len(name)-1], "aeiou"):
+		return name[:len(name)-1] + "ies"
+	case strings.HasSuffix(name, "s"), strings.HasSuffix(name, "x"), strings.HasSuffix(name, "ch"), strings.HasSuffix(name, "sh"):
+		return name + "es"
+	}
+
+	return name + "s"
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"strings"
+)
+
+// header marks the String methods produced by igdbgen as generated, like the
+// String methods produced by the stringer tool.
+const header = "// Code generated by igdbgen; DO NOT EDIT.\n\n"
+
+// stringer returns the String methods of the provided enums in the format
+// of the stringer tool, so that they need not be generated separately.
+func stringer(enums []*Enum) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(header)
+	buf.WriteString("package igdb\n\nimport \"strconv\"\n")
+
+	for _, en := range enums {
+		index := []int{0}
+		for _, v := range en.Values {
+			index = append(index, index[len(index)-1]+len(v))
+		}
+
+		typ := "uint8"
+		if index[len(index)-1] > 255 {
+			typ = "uint16"
+		}
+
+		idx := make([]string, len(index))
+		for i, n := range index {
+			idx[i] = fmt.Sprint(n)
+		}
+
+		fmt.Fprintf(&buf, "\nconst _%s_name = %q\n\n", en.Name, strings.Join(en.Values, ""))
+		fmt.Fprintf(&buf, "var _%s_index = [...]%s{%s}\n\n", en.Name, typ, strings.Join(idx, ", "))
+		fmt.Fprintf(&buf, "func (i %s) String() string {\n", en.Name)
+
+		val := "i"
+		if en.Start != 0 {
+			fmt.Fprintf(&buf, "\ti -= %d\n", en.Start)
+			val = fmt.Sprintf("i+%d", en.Start)
+		}
+
+		fmt.Fprintf(&buf, "\tif i < 0 || i >= %s(len(_%s_index)-1) {\n", en.Name, en.Name)
+		fmt.Fprintf(&buf, "\t\treturn \"%s(\" + strconv.FormatInt(int64(%s), 10) + \")\"\n\t}\n", en.Name, val)
+		fmt.Fprintf(&buf, "\treturn _%s_name[_%s_index[i]:_%s_index[i+1]]\n}\n", en.Name, en.Name, en.Name)
+	}
+
+	return format.Source(buf.Bytes())
+}
@@ -0,0 +1,133 @@
+package igdb
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// WithCoalescing is a client option used to collapse identical concurrent API
+// calls into a single request. API calls are identical when they share the same
+// endpoint and rendered query. Every caller receives the same response but
+// decodes its own copy of the results, so callers cannot affect each other.
+func WithCoalescing() ClientOption {
+	return func(c *Client) {
+		c.flights = &flightGroup{calls: make(map[string]*flight)}
+	}
+}
+
+// flightGroup tracks the requests in flight so that identical
+// concurrent requests can share a single response.
+type flightGroup struct {
+	mu    sync.Mutex
+	calls map[string]*flight
+}
+
+// flight is a request in flight shared by one or more callers.
+type flight struct {
+	done    chan struct{}
+	cancel  context.CancelFunc
+	waiters int
+	body    []byte
+	err     error
+}
+
+// coalescedFetch is like cachedFetch but shares the response to the provided
+// request with every identical request already in flight. The shared request
+// runs until it completes or every caller waiting on it gives up.
+func (c *Client) coalescedFetch(req *http.Request) ([]byte, error) {
+	if c.flights == nil {
+		return c.cachedFetch(req)
+	}
+
+	key := req.Method + " " + c.cacheKey(req)
+	g := c.flights
+
+	g.mu.Lock()
+	f, ok := g.calls[key]
+	if !ok {
+		ctx, cancel := context.WithCancel(detach(req.Context()))
+		f = &flight{done: make(chan struct{}), cancel: cancel}
+		g.calls[key] = f
+
+		shared := req.WithContext(ctx)
+		go func() {
+			f.body, f.err = c.cachedFetch(shared)
+
+			g.mu.Lock()
+			if g.calls[key] == f {
+				delete(g.calls, key)
+			}
+			g.mu.Unlock()
+
+			cancel()
+			close(f.done)
+		}()
+	}
+	f.waiters++
+	g.mu.Unlock()
+
+	select {
+	case <-f.done:
+		return f.body, copyErr(f.err)
+	case <-req.Context().Done():
+		g.mu.Lock()
+		f.waiters--
+		if f.waiters <= 0 {
+			f.cancel()
+			if g.calls[key] == f {
+				delete(g.calls, key)
+			}
+		}
+		g.mu.Unlock()
+
+		return nil, req.Context().Err()
+	}
+}
+
+// copyErr returns a copy of the provided error if it is an *APIError, along
+// with its body and details, so that callers sharing a response cannot modify
+// each other's errors.
+func copyErr(err error) error {
+	if e, ok := err.(*APIError); ok {
+		cp := *e
+		cp.Body = append([]byte(nil), e.Body...)
+		cp.Details = append([]ErrorDetail(nil), e.Details...)
+		return &cp
+	}
+
+	return err
+}
+
+// detachedContext carries the values of its parent context
+// but is never canceled and has no deadline.
+type detachedContext struct {
+	parent context.Context
+}
+
+// detach returns a context carrying the values of the provided context
+// that is not canceled when the provided context is.
+func detach(ctx context.Context) context.Context {
+	return detachedContext{parent: ctx}
+}
+
+// Deadline returns no deadline.
+func (detachedContext) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
+
+// Done returns nil, as a detachedContext is never canceled.
+func (detachedContext) Done() <-chan struct{} {
+	return nil
+}
+
+// Err returns nil, as a detachedContext is never canceled.
+func (detachedContext) Err() error {
+	return nil
+}
+
+// Value returns the value of the parent context associated with the provided key.
+func (d detachedContext) Value(key interface{}) interface{} {
+	return d.parent.Value(key)
+}
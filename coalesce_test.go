@@ -0,0 +1,247 @@
+package igdb
+
+import (
+	"context"
+	"fmt"
+	"github.com/pkg/errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// startBlockingServer initializes and returns a test server that responds
+// with a single Game once the provided channel is closed. The provided
+// counter is incremented for each request received.
+func startBlockingServer(calls *int32, release chan struct{}) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(calls, 1)
+		ioutil.ReadAll(r.Body)
+		select {
+		case <-release:
+		case <-r.Context().Done():
+			return
+		}
+		fmt.Fprint(w, `[{"id": 1942, "name": "The Witcher 3: Wild Hunt"}]`)
+	}))
+}
+
+// waitForWaiters blocks until the provided number of callers wait on the
+// requests in flight of the provided Client.
+func waitForWaiters(t *testing.T, c *Client, n int) {
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		c.flights.mu.Lock()
+		waiters := 0
+		for _, f := range c.flights.calls {
+			waiters += f.waiters
+		}
+		c.flights.mu.Unlock()
+
+		if waiters == n {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatalf("timed out waiting for %d callers", n)
+}
+
+func TestClient_Coalescing(t *testing.T) {
+	var calls int32
+	release := make(chan struct{})
+	ts := startBlockingServer(&calls, release)
+	defer ts.Close()
+
+	c := NewClient(testKey, ts.Client(), WithBaseURL(ts.URL), WithCoalescing())
+
+	const callers = 10
+	games := make([]*Game, callers)
+	errs := make([]error, callers)
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			games[i], errs[i] = c.Games.Get(1942, SetFields("name"))
+		}(i)
+	}
+
+	waitForWaiters(t, c, callers)
+	close(release)
+	wg.Wait()
+
+	if calls != 1 {
+		t.Errorf("got: <%v> calls, want: <%v>", calls, 1)
+	}
+
+	for i := 0; i < callers; i++ {
+		if errs[i] != nil {
+			t.Fatal(errs[i])
+		}
+		if games[i].Name != "The Witcher 3: Wild Hunt" {
+			t.Errorf("got: <%v>, want: <%v>", games[i].Name, "The Witcher 3: Wild Hunt")
+		}
+	}
+
+	games[0].Name = "changed"
+	for i := 1; i < callers; i++ {
+		if games[i] == games[0] || games[i].Name != "The Witcher 3: Wild Hunt" {
+			t.Fatalf("got: <%v>, want each caller to receive its own copy", games[i].Name)
+		}
+	}
+
+	if len(c.flights.calls) != 0 {
+		t.Errorf("got: <%v> requests in flight, want: <%v>", len(c.flights.calls), 0)
+	}
+}
+
+func TestClient_CoalescingErrors(t *testing.T) {
+	release := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `[{"title": "Syntax Error", "status": 400, "cause": "Missing ';' at end of query"}]`)
+	}))
+	defer ts.Close()
+
+	c := NewClient(testKey, ts.Client(), WithBaseURL(ts.URL), WithCoalescing())
+
+	errs := make([]error, 2)
+
+	wg := sync.WaitGroup{}
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = c.Games.Get(1942)
+		}(i)
+	}
+
+	waitForWaiters(t, c, len(errs))
+	close(release)
+	wg.Wait()
+
+	apiErrs := make([]*APIError, len(errs))
+	for i, err := range errs {
+		if !errors.As(err, &apiErrs[i]) {
+			t.Fatalf("got: <%T>, want: <%T>", err, apiErrs[i])
+		}
+	}
+
+	apiErrs[0].Body[0] = '!'
+	apiErrs[0].Details[0].Title = "changed"
+
+	if apiErrs[1].Body[0] != '[' {
+		t.Errorf("got: <%s>, want each caller to receive its own body", apiErrs[1].Body)
+	}
+
+	if apiErrs[1].Details[0].Title != "Syntax Error" {
+		t.Errorf("got: <%v>, want each caller to receive its own details", apiErrs[1].Details[0].Title)
+	}
+}
+
+func TestClient_CoalescingDistinctQueries(t *testing.T) {
+	var calls int32
+	release := make(chan struct{})
+	ts := startBlockingServer(&calls, release)
+	defer ts.Close()
+
+	c := NewClient(testKey, ts.Client(), WithBaseURL(ts.URL), WithCoalescing())
+
+	wg := sync.WaitGroup{}
+	for _, field := range []string{"name", "slug", "name", "slug"} {
+		wg.Add(1)
+		go func(field string) {
+			defer wg.Done()
+			if _, err := c.Games.Index(SetFields(field)); err != nil {
+				t.Error(err)
+			}
+		}(field)
+	}
+
+	waitForWaiters(t, c, 4)
+	close(release)
+	wg.Wait()
+
+	if calls != 2 {
+		t.Errorf("got: <%v> calls, want: <%v>", calls, 2)
+	}
+}
+
+func TestClient_CoalescingCancel(t *testing.T) {
+	var calls int32
+	release := make(chan struct{})
+	ts := startBlockingServer(&calls, release)
+	defer ts.Close()
+
+	c := NewClient(testKey, ts.Client(), WithBaseURL(ts.URL), WithCoalescing())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var canceledErr, otherErr error
+	var other *Game
+
+	wg := sync.WaitGroup{}
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		_, canceledErr = c.Games.GetContext(ctx, 1942)
+	}()
+	go func() {
+		defer wg.Done()
+		other, otherErr = c.Games.GetContext(context.Background(), 1942)
+	}()
+
+	waitForWaiters(t, c, 2)
+	cancel()
+	waitForWaiters(t, c, 1)
+	close(release)
+	wg.Wait()
+
+	if !errors.Is(canceledErr, context.Canceled) {
+		t.Errorf("got: <%v>, want: <%v>", canceledErr, context.Canceled)
+	}
+
+	if otherErr != nil {
+		t.Fatal(otherErr)
+	}
+
+	if other.ID != 1942 {
+		t.Errorf("got: <%v>, want: <%v>", other.ID, 1942)
+	}
+
+	if calls != 1 {
+		t.Errorf("got: <%v> calls, want: <%v>", calls, 1)
+	}
+}
+
+func TestClient_CoalescingAbandoned(t *testing.T) {
+	var calls int32
+	release := make(chan struct{})
+	defer close(release)
+	ts := startBlockingServer(&calls, release)
+	defer ts.Close()
+
+	c := NewClient(testKey, ts.Client(), WithBaseURL(ts.URL), WithCoalescing())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := c.Games.GetContext(ctx, 1942)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got: <%v>, want: <%v>", err, context.DeadlineExceeded)
+	}
+
+	c.flights.mu.Lock()
+	n := len(c.flights.calls)
+	c.flights.mu.Unlock()
+
+	if n != 0 {
+		t.Errorf("got: <%v> requests in flight, want: <%v>", n, 0)
+	}
+}
@@ -36,7 +36,7 @@ func TestCompanyWebsiteService_Get(t *testing.T) {
 	}{
 		{"Valid response", testCompanyWebsiteGet, 1707, []Option{SetFields("name")}, init[0], nil},
 		{"Invalid ID", testFileEmpty, -1, nil, nil, ErrNegativeID},
-		{"Empty response", testFileEmpty, 1707, nil, nil, errInvalidJSON},
+		{"Empty response", testFileEmpty, 1707, nil, nil, ErrInvalidJSON},
 		{"Invalid option", testFileEmpty, 1707, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
 		{"No results", testFileEmptyArray, 0, nil, nil, ErrNoResults},
 	}
@@ -83,7 +83,7 @@ func TestCompanyWebsiteService_List(t *testing.T) {
 		{"Valid response", testCompanyWebsiteList, []int{1709, 453, 1710, 1322, 133}, []Option{SetLimit(5)}, init, nil},
 		{"Zero IDs", testFileEmpty, nil, nil, nil, ErrEmptyIDs},
 		{"Invalid ID", testFileEmpty, []int{-500}, nil, nil, ErrNegativeID},
-		{"Empty response", testFileEmpty, []int{1709, 453, 1710, 1322, 133}, nil, nil, errInvalidJSON},
+		{"Empty response", testFileEmpty, []int{1709, 453, 1710, 1322, 133}, nil, nil, ErrInvalidJSON},
 		{"Invalid option", testFileEmpty, []int{1709, 453, 1710, 1322, 133}, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
 		{"No results", testFileEmptyArray, []int{0, 9999999}, nil, nil, ErrNoResults},
 	}
@@ -127,7 +127,7 @@ func TestCompanyWebsiteService_Index(t *testing.T) {
 		wantErr             error
 	}{
 		{"Valid response", testCompanyWebsiteList, []Option{SetLimit(5)}, init, nil},
-		{"Empty response", testFileEmpty, nil, nil, errInvalidJSON},
+		{"Empty response", testFileEmpty, nil, nil, ErrInvalidJSON},
 		{"Invalid option", testFileEmpty, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
 		{"No results", testFileEmptyArray, nil, nil, ErrNoResults},
 	}
@@ -160,7 +160,7 @@ func TestCompanyWebsiteService_Count(t *testing.T) {
 		wantErr   error
 	}{
 		{"Happy path", `{"count": 100}`, []Option{SetFilter("popularity", OpGreaterThan, "75")}, 100, nil},
-		{"Empty response", "", nil, 0, errInvalidJSON},
+		{"Empty response", "", nil, 0, ErrInvalidJSON},
 		{"Invalid option", "", []Option{SetLimit(-100)}, 0, ErrOutOfRange},
 		{"No results", "[]", nil, 0, ErrNoResults},
 	}
@@ -192,7 +192,7 @@ func TestCompanyWebsiteService_Fields(t *testing.T) {
 	}{
 		{"Happy path", `["name", "slug", "url"]`, []string{"url", "slug", "name"}, nil},
 		{"Asterisk", `["*"]`, []string{"*"}, nil},
-		{"Empty response", "", nil, errInvalidJSON},
+		{"Empty response", "", nil, ErrInvalidJSON},
 		{"No results", "[]", nil, nil},
 	}
 
@@ -0,0 +1,210 @@
+package igdb
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// DriftKind is the kind of a difference between the fields of an IGDB
+// endpoint and the JSON tags of the struct representing its objects.
+type DriftKind string
+
+// Expected DriftKinds.
+const (
+	// DriftMissing is a field of the endpoint without a struct field.
+	DriftMissing DriftKind = "missing"
+	// DriftExtra is a struct field without a field of the endpoint.
+	DriftExtra DriftKind = "extra"
+	// DriftMistagged is a struct field representing a field of the
+	// endpoint under a JSON tag other than the name of the field.
+	DriftMistagged DriftKind = "mistagged"
+)
+
+// FieldDrift is a single difference between the fields of an IGDB endpoint
+// and the JSON tags of the struct representing its objects.
+type FieldDrift struct {
+	Kind DriftKind `json:"kind"`
+	// Field is the name of the field of the endpoint. It is
+	// empty for DriftExtra.
+	Field string `json:"field,omitempty"`
+	// GoField is the name of the struct field. It is
+	// empty for DriftMissing.
+	GoField string `json:"go_field,omitempty"`
+	// Tag is the JSON name of the struct field. It is
+	// empty for DriftMissing.
+	Tag string `json:"tag,omitempty"`
+}
+
+// DriftReport is the schema drift of a single IGDB endpoint.
+type DriftReport struct {
+	// Endpoint is the path of the endpoint (e.g. "games").
+	Endpoint string `json:"endpoint"`
+	// Type is the name of the struct representing the objects of
+	// the endpoint (e.g. "Game").
+	Type  string       `json:"type"`
+	Drift []FieldDrift `json:"drift,omitempty"`
+	// Err is the error encountered while retrieving the fields of
+	// the endpoint, if any.
+	Err error `json:"-"`
+}
+
+// drifter is implemented by every service of the Client.
+type drifter interface {
+	drift(ctx context.Context) *DriftReport
+}
+
+// DetectDrift compares the fields of every endpoint of the Client, as
+// reported by its meta endpoint, with the JSON tags of the struct
+// representing its objects. A report is returned for every endpoint in the
+// order of the services of the Client, including the endpoints without any
+// drift. An endpoint whose fields cannot be retrieved has the error set in
+// its report instead. Provide a Client using a recorded transport, such as
+// the Recorder of the igdbtest package, to compare recorded fields instead.
+func (c *Client) DetectDrift(ctx context.Context) ([]*DriftReport, error) {
+	v := reflect.ValueOf(c).Elem()
+
+	var reports []*DriftReport
+	for i := 0; i < v.NumField(); i++ {
+		if v.Type().Field(i).PkgPath != "" || v.Field(i).IsNil() {
+			continue
+		}
+
+		d, ok := v.Field(i).Interface().(drifter)
+		if !ok {
+			continue
+		}
+
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		reports = append(reports, d.drift(ctx))
+	}
+
+	return reports, nil
+}
+
+// drift compares the fields of the endpoint of the Service with the
+// JSON tags of T.
+func (s *Service[T]) drift(ctx context.Context) *DriftReport {
+	r := &DriftReport{Endpoint: strings.TrimSuffix(string(s.end), "/"), Type: s.name}
+
+	f, err := s.FieldsContext(ctx)
+	if err != nil {
+		r.Err = err
+		return r
+	}
+
+	r.Drift = DiffFields(new(T), f)
+	return r
+}
+
+// DiffFields compares the provided fields of an IGDB endpoint with the JSON
+// tags of the provided struct, or pointer to struct, representing its
+// objects. The fields of embedded structs are compared as well. A struct
+// field whose tag is not one of the provided fields but whose tag or name
+// matches one of them once case and underscores are ignored is reported as
+// mistagged rather than as extra. Mistagged and extra fields are reported
+// in the order of the struct, followed by the missing fields in order.
+func DiffFields(v interface{}, fields []string) []FieldDrift {
+	want := make(map[string]bool, len(fields))
+	for _, f := range fields {
+		want[f] = true
+	}
+
+	sfs := jsonFields(reflect.TypeOf(v))
+
+	matched := make(map[string]bool)
+	for _, sf := range sfs {
+		if want[sf.tag] {
+			matched[sf.tag] = true
+		}
+	}
+
+	var drift []FieldDrift
+	for _, sf := range sfs {
+		if want[sf.tag] {
+			continue
+		}
+
+		d := FieldDrift{Kind: DriftExtra, GoField: sf.name, Tag: sf.tag}
+		for _, f := range fields {
+			if !matched[f] && (fold(f) == fold(sf.tag) || fold(f) == fold(sf.name)) {
+				d.Kind, d.Field = DriftMistagged, f
+				matched[f] = true
+				break
+			}
+		}
+		drift = append(drift, d)
+	}
+
+	var missing []string
+	for f := range want {
+		if !matched[f] {
+			missing = append(missing, f)
+		}
+	}
+	sort.Strings(missing)
+
+	for _, f := range missing {
+		drift = append(drift, FieldDrift{Kind: DriftMissing, Field: f})
+	}
+
+	return drift
+}
+
+// jsonField is a struct field decoded from JSON.
+type jsonField struct {
+	name string
+	tag  string
+}
+
+// jsonFields returns the fields of the provided struct type decoded from
+// JSON, including the fields of its embedded structs, in order.
+func jsonFields(t reflect.Type) []jsonField {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var fs []jsonField
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+
+		tag := strings.Split(sf.Tag.Get("json"), ",")[0]
+		if tag == "-" {
+			continue
+		}
+
+		if sf.Anonymous && tag == "" {
+			ft := sf.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				fs = append(fs, jsonFields(ft)...)
+				continue
+			}
+		}
+
+		if sf.PkgPath != "" {
+			continue
+		}
+
+		if tag == "" {
+			tag = sf.Name
+		}
+		fs = append(fs, jsonField{name: sf.Name, tag: tag})
+	}
+
+	return fs
+}
+
+// fold returns the provided name in lower case without underscores.
+func fold(name string) string {
+	return strings.ToLower(strings.Replace(name, "_", "", -1))
+}
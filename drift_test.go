@@ -0,0 +1,200 @@
+package igdb
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+// driftItem is the object of the DiffFields tests.
+type driftItem struct {
+	Image
+	ID          int    `json:"ID"`
+	Name        string `json:"name"`
+	ReleaseDate int    `json:"date"`
+	Ignored     string `json:"-"`
+	Untagged    string
+	unexported  string
+}
+
+func TestDiffFields(t *testing.T) {
+	image := []string{"alpha_channel", "animated", "height", "image_id", "url", "width"}
+
+	var tests = []struct {
+		name   string
+		v      interface{}
+		fields []string
+		want   []FieldDrift
+	}{
+		{
+			"No drift",
+			Cover{},
+			append([]string{"id", "game"}, image...),
+			nil,
+		},
+		{
+			"Pointer to struct",
+			&Cover{},
+			append([]string{"id", "game"}, image...),
+			nil,
+		},
+		{
+			"Missing fields",
+			Cover{},
+			append([]string{"id", "game", "checksum", "animated_at"}, image...),
+			[]FieldDrift{{Kind: DriftMissing, Field: "animated_at"}, {Kind: DriftMissing, Field: "checksum"}},
+		},
+		{
+			"Extra fields",
+			Cover{},
+			[]string{"id", "game"},
+			[]FieldDrift{
+				{Kind: DriftExtra, GoField: "AlphaChannel", Tag: "alpha_channel"},
+				{Kind: DriftExtra, GoField: "Animated", Tag: "animated"},
+				{Kind: DriftExtra, GoField: "Height", Tag: "height"},
+				{Kind: DriftExtra, GoField: "ImageID", Tag: "image_id"},
+				{Kind: DriftExtra, GoField: "URL", Tag: "url"},
+				{Kind: DriftExtra, GoField: "Width", Tag: "width"},
+			},
+		},
+		{
+			"Mistagged fields",
+			driftItem{},
+			append([]string{"id", "name", "release_date", "untagged"}, image...),
+			[]FieldDrift{
+				{Kind: DriftMistagged, Field: "id", GoField: "ID", Tag: "ID"},
+				{Kind: DriftMistagged, Field: "release_date", GoField: "ReleaseDate", Tag: "date"},
+				{Kind: DriftMistagged, Field: "untagged", GoField: "Untagged", Tag: "Untagged"},
+			},
+		},
+		{
+			"Exact match over mistag",
+			driftItem{},
+			append([]string{"ID", "id", "name", "date", "Untagged"}, image...),
+			[]FieldDrift{{Kind: DriftMissing, Field: "id"}},
+		},
+		{
+			"Not a struct",
+			5,
+			[]string{"id"},
+			[]FieldDrift{{Kind: DriftMissing, Field: "id"}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := DiffFields(test.v, test.fields)
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got: <%v>, want: <%v>", got, test.want)
+			}
+		})
+	}
+}
+
+// metaFields returns the JSON tags of the provided struct, replacing the
+// provided tags with the provided fields, as a stand-in for the fields
+// reported by the meta endpoint of the IGDB.
+func metaFields(v interface{}, replace map[string]string) []string {
+	var fs []string
+	for _, f := range jsonFields(reflect.TypeOf(v)) {
+		if r, ok := replace[f.tag]; ok {
+			fs = append(fs, r)
+			continue
+		}
+		fs = append(fs, f.tag)
+	}
+
+	return fs
+}
+
+func TestClient_DetectDrift(t *testing.T) {
+	meta := map[string][]string{
+		"/private/test_dummies/meta": metaFields(TestDummy{}, map[string]string{"int": "id"}),
+		"/characters/meta":           append(metaFields(Character{}, map[string]string{"ID": "id"}), "checksum"),
+		"/covers/meta":               metaFields(Cover{}, nil),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fs, ok := meta[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		fmt.Fprintf(w, `["%s"]`, strings.Join(fs, `", "`))
+	}))
+	defer ts.Close()
+
+	c := NewClient(testKey, ts.Client(), WithBaseURL(ts.URL))
+
+	reports, err := c.DetectDrift(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	found := make(map[string]*DriftReport)
+	for _, r := range reports {
+		found[r.Endpoint] = r
+	}
+
+	services := 0
+	v := reflect.ValueOf(c).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if strings.HasSuffix(v.Field(i).Type().String(), "Service") {
+			services++
+		}
+	}
+
+	if len(found) != len(reports) || len(reports) != services {
+		t.Errorf("got: <%v> reports for <%v> endpoints, want: <%v>", len(reports), len(found), services)
+	}
+
+	var tests = []struct {
+		end      string
+		wantType string
+		want     []FieldDrift
+		wantErr  error
+	}{
+		{"private/test_dummies", "TestDummy", []FieldDrift{{Kind: DriftMistagged, Field: "id", GoField: "ID", Tag: "int"}}, nil},
+		{"characters", "Character", []FieldDrift{{Kind: DriftMistagged, Field: "id", GoField: "ID", Tag: "ID"}, {Kind: DriftMissing, Field: "checksum"}}, nil},
+		{"covers", "Cover", nil, nil},
+		{"games", "Game", nil, ErrBadRequest},
+	}
+
+	for _, test := range tests {
+		t.Run(test.end, func(t *testing.T) {
+			r, ok := found[test.end]
+			if !ok {
+				t.Fatalf("got: no report, want report for <%v>", test.end)
+			}
+
+			if r.Type != test.wantType {
+				t.Errorf("got: <%v>, want: <%v>", r.Type, test.wantType)
+			}
+
+			if !reflect.DeepEqual(r.Drift, test.want) {
+				t.Errorf("got: <%v>, want: <%v>", r.Drift, test.want)
+			}
+
+			if errors.Cause(r.Err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(r.Err), test.wantErr)
+			}
+		})
+	}
+}
+
+func TestClient_DetectDrift_Canceled(t *testing.T) {
+	c := NewClient(testKey, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := c.DetectDrift(ctx); err != context.Canceled {
+		t.Errorf("got: <%v>, want: <%v>", err, context.Canceled)
+	}
+}
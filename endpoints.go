@@ -1,5 +1,7 @@
 package igdb
 
+import "context"
+
 type endpoint string
 
 // Public IGDB API endpoints
@@ -77,6 +79,9 @@ const (
 // EndpointStatus is a unique endpoint for checking the status of the API.
 const EndpointStatus endpoint = "api_status"
 
+// EndpointMultiQuery is a unique endpoint for sending multiple queries in a single API call.
+const EndpointMultiQuery endpoint = "multiquery"
+
 // Count contains the number of objects
 // of a certain type counted in the IGDB.
 type Count struct {
@@ -85,15 +90,15 @@ type Count struct {
 
 // getFields returns a list of fields that represent the
 // model of the data available at the given IGDB endpoint.
-func (c *Client) getFields(end endpoint) ([]string, error) {
-	req, err := c.request(end + "meta")
+func (c *Client) getFields(ctx context.Context, end endpoint) ([]string, error) {
+	req, err := c.request(ctx, end+"meta")
 	if err != nil {
 		return nil, err
 	}
 
 	var f []string
 
-	if err = c.send(req, &f); err != nil && err != ErrNoResults {
+	if err = c.send(req, &f); err != nil && !isEmptyResult(err) {
 		return nil, err
 	}
 
@@ -101,8 +106,8 @@ func (c *Client) getFields(end endpoint) ([]string, error) {
 }
 
 // getCount returns the count of entities available for the given IGDB endpoint.
-func (c *Client) getCount(end endpoint, opts ...Option) (int, error) {
-	req, err := c.request(end+"count", opts...)
+func (c *Client) getCount(ctx context.Context, end endpoint, opts ...Option) (int, error) {
+	req, err := c.request(ctx, end+"count", opts...)
 	if err != nil {
 		return 0, err
 	}
@@ -1,6 +1,7 @@
 package igdb
 
 import (
+	"context"
 	"github.com/pkg/errors"
 	"net/http"
 	"reflect"
@@ -16,7 +17,7 @@ func TestClient_GetFields(t *testing.T) {
 		wantErr    error
 	}{
 		{"OK status with regular response", http.StatusOK, `["name", "slug", "url"]`, []string{"url", "slug", "name"}, nil},
-		{"OK status with empty response", http.StatusOK, "", nil, errInvalidJSON},
+		{"OK status with empty response", http.StatusOK, "", nil, ErrInvalidJSON},
 		{"OK status with dot response", http.StatusOK, `["mugshot.width","name", "company.id"]`, []string{"company.id", "name", "mugshot.width"}, nil},
 		{"OK status with asterisk response", http.StatusOK, `["*"]`, []string{"*"}, nil},
 		{"Bad status with empty response", http.StatusBadRequest, "", nil, ErrBadRequest},
@@ -28,7 +29,7 @@ func TestClient_GetFields(t *testing.T) {
 			ts, c := testServerString(test.status, test.resp)
 			defer ts.Close()
 
-			f, err := c.getFields(testEndpoint)
+			f, err := c.getFields(context.Background(), testEndpoint)
 			if !reflect.DeepEqual(errors.Cause(err), test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
@@ -50,7 +51,7 @@ func TestClient_GetCount(t *testing.T) {
 	}{
 		{"OK status with regular response", http.StatusOK, `{"count": 1234}`, 1234, nil},
 		{"OK status with count of zero response", http.StatusOK, `{"count": 0}`, 0, nil},
-		{"OK status with empty response", http.StatusOK, "", 0, errInvalidJSON},
+		{"OK status with empty response", http.StatusOK, "", 0, ErrInvalidJSON},
 		{"Bad status with empty response", http.StatusBadRequest, "", 0, ErrBadRequest},
 		{"Not found status with error response", http.StatusNotFound, testErrNotFound, 0, ServerError{Status: 404, Msg: "status not found"}},
 	}
@@ -60,7 +61,7 @@ func TestClient_GetCount(t *testing.T) {
 			ts, c := testServerString(test.status, test.resp)
 			defer ts.Close()
 
-			count, err := c.getCount(testEndpoint)
+			count, err := c.getCount(context.Background(), testEndpoint)
 			if !reflect.DeepEqual(errors.Cause(err), test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
@@ -2,11 +2,13 @@ package igdb
 
 import (
 	"encoding/json"
-	"github.com/pkg/errors"
+	"fmt"
 	"io/ioutil"
-	"log"
 	"net/http"
 	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
 )
 
 var (
@@ -14,10 +16,15 @@ var (
 	ErrNegativeID = errors.New("ID cannot be negative")
 	// ErrEmptyIDs occurs when a List function is called without a populated int slice.
 	ErrEmptyIDs = errors.New("IDs argument empty")
+	// ErrNotFound occurs when the IGDB cannot find any results for a query, either
+	// by returning an empty array or by responding with a Not Found status.
+	ErrNotFound = errors.New("no results found")
 	// ErrNoResults occurs when the IGDB returns an empty array, void of results.
-	ErrNoResults = errors.New("results are empty")
-	// errInvalidJSON occurs when encountering an unexpected end of JSON input.
-	errInvalidJSON = errors.New("invalid JSON")
+	//
+	// Deprecated: ErrNoResults is the same error as ErrNotFound. Use ErrNotFound instead.
+	ErrNoResults = ErrNotFound
+	// ErrInvalidJSON occurs when a response from the IGDB cannot be decoded.
+	ErrInvalidJSON = errors.New("invalid JSON")
 )
 
 // Errors returned when encountering error status codes.
@@ -30,12 +37,17 @@ var (
 	// ErrUnauthorized occurs when a request is made without authorization.
 	ErrUnauthorized = ServerError{
 		Status: http.StatusUnauthorized,
-		Msg:    "authentication failed: check for valid API key in user-key header",
+		Msg:    "authentication failed: check for valid API key or Twitch credentials",
 	}
 	// ErrForbidden occurs when a request is made without authorization.
 	ErrForbidden = ServerError{
 		Status: http.StatusForbidden,
-		Msg:    "authentication failed: check for valid API key in user-key header",
+		Msg:    "authentication failed: check for valid API key or Twitch credentials",
+	}
+	// ErrTooManyRequests occurs when the rate limit of the IGDB is exceeded.
+	ErrTooManyRequests = ServerError{
+		Status: http.StatusTooManyRequests,
+		Msg:    "too many requests: slow down or retry later",
 	}
 	// ErrInternalError occurs when an unexpected IGDB server error occurs and should be reported.
 	ErrInternalError = ServerError{
@@ -56,36 +68,153 @@ func (e ServerError) Error() string {
 	return "igdb server error: status: " + strconv.Itoa(e.Status) + " message: " + e.Msg
 }
 
-// checkResponse checks the provided HTTP response
-// for errors returned by the IGDB.
+//go:generate gomodifytags -file $GOFILE -struct ErrorDetail -add-tags json -w
+
+// ErrorDetail contains the details the IGDB provides alongside an error status,
+// such as the cause of a syntax error in a query.
+type ErrorDetail struct {
+	Title  string `json:"title"`
+	Status int    `json:"status"`
+	Cause  string `json:"cause"`
+}
+
+// APIError describes a failed API call. It carries the status code of the
+// response, the endpoint and rendered query of the request, the raw response
+// body, and any error details provided by the IGDB.
+//
+// APIError wraps one of the sentinel errors of this package (e.g. ErrNotFound
+// or ErrBadRequest) so that it can be inspected with errors.Is, while the
+// APIError itself can be retrieved with errors.As.
+type APIError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// Endpoint is the IGDB endpoint the request was sent to (e.g. "games/").
+	Endpoint string
+	// Query is the Apicalypse query sent in the body of the request.
+	Query string
+	// Body is the raw body of the response.
+	Body []byte
+	// Details are the error details provided by the IGDB, if any.
+	Details []ErrorDetail
+	// Err is the underlying error.
+	Err error
+}
+
+// Error formats the APIError and fulfills the error interface.
+func (e *APIError) Error() string {
+	b := strings.Builder{}
+	b.WriteString("igdb: ")
+	if e.Endpoint != "" {
+		b.WriteString(e.Endpoint + ": ")
+	}
+	fmt.Fprintf(&b, "status %d: %v", e.StatusCode, e.Err)
+
+	for _, d := range e.Details {
+		fmt.Fprintf(&b, " (%s: %s)", d.Title, d.Cause)
+	}
+
+	return b.String()
+}
+
+// Unwrap returns the underlying error.
+func (e *APIError) Unwrap() error {
+	return e.Err
+}
+
+// Cause returns the underlying error. Cause allows errors.Cause from
+// the github.com/pkg/errors package to find the underlying error.
+func (e *APIError) Cause() error {
+	return e.Err
+}
+
+// Is reports whether the APIError matches the provided target. An APIError
+// with a Not Found status matches ErrNotFound and an APIError matches any
+// target APIError with the same status code.
+func (e *APIError) Is(target error) bool {
+	if target == ErrNotFound {
+		return e.StatusCode == http.StatusNotFound
+	}
+
+	t, ok := target.(*APIError)
+	if !ok {
+		return false
+	}
+
+	return t.StatusCode == e.StatusCode
+}
+
+// isEmptyResult reports whether the provided error was caused by the IGDB
+// returning an empty array, void of results.
+func isEmptyResult(err error) bool {
+	return errors.Cause(err) == ErrNotFound
+}
+
+// checkResponse checks the provided HTTP response for errors returned by
+// the IGDB. Any error status is returned as an *APIError carrying the body
+// of the response.
 func checkResponse(resp *http.Response) error {
-	switch resp.StatusCode {
-	case http.StatusOK:
+	if resp.StatusCode == http.StatusOK {
 		return nil
+	}
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Wrap(err, "cannot read error response body")
+	}
+
+	e := &APIError{
+		StatusCode: resp.StatusCode,
+		Body:       b,
+		Details:    parseDetails(b),
+	}
+
+	switch resp.StatusCode {
 	case http.StatusBadRequest:
-		return ErrBadRequest
+		e.Err = ErrBadRequest
 	case http.StatusUnauthorized:
-		return ErrUnauthorized
+		e.Err = ErrUnauthorized
 	case http.StatusForbidden:
-		return ErrForbidden
+		e.Err = ErrForbidden
+	case http.StatusTooManyRequests:
+		e.Err = ErrTooManyRequests
 	case http.StatusInternalServerError:
-		return ErrInternalError
+		e.Err = ErrInternalError
+	default:
+		e.Err = parseServerError(resp.StatusCode, b)
 	}
 
-	b, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return err
+	return e
+}
+
+// parseDetails returns the error details found in the provided response body.
+// The IGDB provides either a single error detail or an array of them.
+func parseDetails(b []byte) []ErrorDetail {
+	var details []ErrorDetail
+	if err := json.Unmarshal(b, &details); err == nil {
+		return details
 	}
-	log.Println(string(b))
 
-	var e ServerError
+	var d ErrorDetail
+	if err := json.Unmarshal(b, &d); err == nil && (d.Title != "" || d.Cause != "") {
+		return []ErrorDetail{d}
+	}
 
-	err = json.Unmarshal(b, &e)
-	if err != nil {
-		return errors.Wrap(err, "could not unmarshal server error message")
+	return nil
+}
+
+// parseServerError returns the ServerError found in the provided response body.
+// If the body does not contain a ServerError, a ServerError describing the
+// provided status code is returned instead.
+func parseServerError(status int, b []byte) ServerError {
+	var e ServerError
+	if err := json.Unmarshal(b, &e); err == nil && e.Status != 0 {
+		return e
 	}
 
-	return e
+	return ServerError{
+		Status: status,
+		Msg:    strings.ToLower(http.StatusText(status)),
+	}
 }
 
 // Byte representations of ASCII characters. Used for empty result checks.
@@ -27,6 +27,7 @@ func TestCheckResponse(t *testing.T) {
 		{"Status Bad Request", http.StatusBadRequest, "", ErrBadRequest},
 		{"Status Unauthorized", http.StatusUnauthorized, "", ErrUnauthorized},
 		{"Status Forbidden", http.StatusForbidden, "", ErrForbidden},
+		{"Status Too Many Requests", http.StatusTooManyRequests, "", ErrTooManyRequests},
 		{"Status Internal Server Error", http.StatusInternalServerError, "", ErrInternalError},
 		{"Unexpected Status Not Found", http.StatusNotFound, testErrNotFound, ServerError{Status: 404, Msg: "status not found"}},
 	}
@@ -75,3 +76,133 @@ func TestIsBracketPair(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckResponse_Details(t *testing.T) {
+	var tests = []struct {
+		name        string
+		code        int
+		body        string
+		wantDetails []ErrorDetail
+		wantErr     error
+	}{
+		{
+			"Detail array",
+			http.StatusBadRequest,
+			`[{"title": "Syntax Error", "status": 400, "cause": "Missing ';' at end of query"}]`,
+			[]ErrorDetail{{Title: "Syntax Error", Status: 400, Cause: "Missing ';' at end of query"}},
+			ErrBadRequest,
+		},
+		{
+			"Single detail",
+			http.StatusUnauthorized,
+			`{"title": "Authorization Failure", "status": 401, "cause": "Invalid token"}`,
+			[]ErrorDetail{{Title: "Authorization Failure", Status: 401, Cause: "Invalid token"}},
+			ErrUnauthorized,
+		},
+		{"Plain text body", http.StatusBadGateway, "upstream unavailable", nil, ServerError{Status: 502, Msg: "bad gateway"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp := &http.Response{StatusCode: test.code,
+				Body: ioutil.NopCloser(strings.NewReader(test.body)),
+			}
+
+			err := checkResponse(resp)
+
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("got: <%T>, want: <%T>", err, apiErr)
+			}
+
+			if apiErr.StatusCode != test.code {
+				t.Errorf("got: <%v>, want: <%v>", apiErr.StatusCode, test.code)
+			}
+
+			if string(apiErr.Body) != test.body {
+				t.Errorf("got: <%v>, want: <%v>", string(apiErr.Body), test.body)
+			}
+
+			if !reflect.DeepEqual(apiErr.Details, test.wantDetails) {
+				t.Errorf("got: <%v>, want: <%v>", apiErr.Details, test.wantDetails)
+			}
+
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", err, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestAPIError_Is(t *testing.T) {
+	var tests = []struct {
+		name   string
+		err    error
+		target error
+		want   bool
+	}{
+		{"Empty result is not found", &APIError{StatusCode: 200, Err: ErrNotFound}, ErrNotFound, true},
+		{"Empty result is no results", &APIError{StatusCode: 200, Err: ErrNotFound}, ErrNoResults, true},
+		{"Not found status is not found", &APIError{StatusCode: 404, Err: ServerError{Status: 404}}, ErrNotFound, true},
+		{"Bad request is not not found", &APIError{StatusCode: 400, Err: ErrBadRequest}, ErrNotFound, false},
+		{"Bad request is bad request", &APIError{StatusCode: 400, Err: ErrBadRequest}, ErrBadRequest, true},
+		{"Wrapped bad request", errors.Wrap(&APIError{StatusCode: 400, Err: ErrBadRequest}, "cannot get Game"), ErrBadRequest, true},
+		{"Same status code", &APIError{StatusCode: 503}, &APIError{StatusCode: 503}, true},
+		{"Different status code", &APIError{StatusCode: 503}, &APIError{StatusCode: 502}, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := errors.Is(test.err, test.target); got != test.want {
+				t.Errorf("got: <%v>, want: <%v>", got, test.want)
+			}
+		})
+	}
+}
+
+func TestClient_APIError(t *testing.T) {
+	var tests = []struct {
+		name       string
+		status     int
+		resp       string
+		wantStatus int
+		wantErr    error
+	}{
+		{"Empty array", http.StatusOK, "[]", http.StatusOK, ErrNotFound},
+		{"Bad request", http.StatusBadRequest, `[{"title": "Syntax Error", "status": 400}]`, http.StatusBadRequest, ErrBadRequest},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerString(test.status, test.resp)
+			defer ts.Close()
+
+			_, err := c.Games.Index(SetFields("name"), SetLimit(5))
+
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("got: <%T>, want: <%T>", err, apiErr)
+			}
+
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", err, test.wantErr)
+			}
+
+			if apiErr.StatusCode != test.wantStatus {
+				t.Errorf("got: <%v>, want: <%v>", apiErr.StatusCode, test.wantStatus)
+			}
+
+			if apiErr.Endpoint != string(EndpointGame) {
+				t.Errorf("got: <%v>, want: <%v>", apiErr.Endpoint, EndpointGame)
+			}
+
+			if apiErr.Query != "fields name; limit 5; " {
+				t.Errorf("got: <%v>, want: <%v>", apiErr.Query, "fields name; limit 5; ")
+			}
+
+			if string(apiErr.Body) != test.resp {
+				t.Errorf("got: <%v>, want: <%v>", string(apiErr.Body), test.resp)
+			}
+		})
+	}
+}
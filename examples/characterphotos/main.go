@@ -26,7 +26,7 @@ func main() {
 	// Retrieve human character photos
 	ch, err := c.Characters.Index(
 		igdb.SetLimit(20),
-		igdb.SetFields("name", "mug_shot"),
+		igdb.SetFields("name", "mug_shot.image_id"),          // expand mugshots into the same request
 		igdb.SetFilter("species", igdb.OpEquals, "1"),        // only humans
 		igdb.SetFilter("mug_shot", igdb.OpNotEquals, "null"), // only characters with images
 		igdb.SetOrder("created_at", igdb.OrderDescending),    // most recently created
@@ -37,8 +37,8 @@ func main() {
 
 	fmt.Print("The 20 Newest Character Photos:\n\n")
 	for _, v := range ch {
-		mugshot, err := c.CharacterMugshots.Get(v.MugShot, igdb.SetFields("image_id")) // retrieve mugshot ID
-		if err != nil {
+		var mugshot igdb.CharacterMugshot
+		if err := v.MugShot.Decode(&mugshot); err != nil { // decode expanded mugshot
 			log.Fatal(err)
 		}
 
@@ -26,7 +26,7 @@ func main() {
 	// Composing options set to retrieve top 5 popular results
 	byPop := igdb.ComposeOptions(
 		igdb.SetLimit(5),
-		igdb.SetFields("name", "cover"),
+		igdb.SetFields("name", "cover.image_id"), // expand covers into the same request
 		igdb.SetOrder("popularity", igdb.OrderDescending),
 		igdb.SetFilter("category", igdb.OpEquals, "0"),
 		igdb.SetFilter("cover", igdb.OpNotEquals, "null"),
@@ -52,8 +52,8 @@ func main() {
 
 	fmt.Println("Top 5 PS4 Games:")
 	for _, game := range PS4 {
-		cover, err := c.Covers.Get(game.Cover, igdb.SetFields("image_id")) // retrieve cover IDs
-		if err != nil {
+		var cover igdb.Cover
+		if err := game.Cover.Decode(&cover); err != nil { // decode expanded cover
 			log.Fatal(err)
 		}
 		img, err := cover.SizedURL(igdb.Size1080p, 1) // resize to largest image available
@@ -65,8 +65,8 @@ func main() {
 
 	fmt.Println("\nTop 5 XBOX Games:")
 	for _, game := range XBOX {
-		cover, err := c.Covers.Get(game.Cover, igdb.SetFields("id", "image_id")) // retrieve cover IDs
-		if err != nil {
+		var cover igdb.Cover
+		if err := game.Cover.Decode(&cover); err != nil { // decode expanded cover
 			log.Fatal(err)
 		}
 		img, err := cover.SizedURL(igdb.Size1080p, 1) // resize to largest image available
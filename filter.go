@@ -0,0 +1,406 @@
+package igdb
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Henry-Sarabia/apicalypse"
+	"github.com/Henry-Sarabia/blank"
+	"github.com/pkg/errors"
+)
+
+// ErrInvalidOperand occurs when a filter operand cannot be rendered into a query.
+var ErrInvalidOperand = errors.New("provided filter operand is of an unsupported type")
+
+// Logical operators used to combine filter expressions.
+const (
+	logicalAnd = " & "
+	logicalOr  = " | "
+)
+
+// Filter is a boolean filter expression used to filter the results from an API
+// call. Filters are created by comparing a field to a typed operand (e.g. Eq or
+// Gt) and combined with And, Or, and Not. Combined filters are grouped with
+// parentheses as needed so that they are evaluated in the order they were built.
+//
+// A Filter is passed to an API call using the SetWhere functional option.
+//
+// For more information, visit: https://api-docs.igdb.com/#filters
+type Filter struct {
+	expr string
+	op   string
+	err  error
+}
+
+// String returns the Filter rendered as an Apicalypse where clause.
+func (f Filter) String() string {
+	return f.expr
+}
+
+// Err returns the first error encountered while building the Filter, if any.
+func (f Filter) Err() error {
+	return f.err
+}
+
+// And returns a Filter matching results that satisfy both f and every other
+// provided Filter.
+func (f Filter) And(others ...Filter) Filter {
+	return And(append([]Filter{f}, others...)...)
+}
+
+// Or returns a Filter matching results that satisfy either f or any other
+// provided Filter.
+func (f Filter) Or(others ...Filter) Filter {
+	return Or(append([]Filter{f}, others...)...)
+}
+
+// Not returns a Filter matching results that do not satisfy f.
+func (f Filter) Not() Filter {
+	return Not(f)
+}
+
+// group returns the Filter rendered for use inside a combination of filters
+// joined by the provided logical operator. A combination joined by a different
+// operator is wrapped in parentheses.
+func (f Filter) group(op string) string {
+	if f.op != "" && f.op != op {
+		return "(" + f.expr + ")"
+	}
+
+	return f.expr
+}
+
+// And returns a Filter matching results that satisfy every provided Filter.
+func And(filters ...Filter) Filter {
+	return combine(logicalAnd, filters)
+}
+
+// Or returns a Filter matching results that satisfy any provided Filter.
+func Or(filters ...Filter) Filter {
+	return combine(logicalOr, filters)
+}
+
+// Not returns a Filter matching results that do not satisfy the provided Filter.
+func Not(f Filter) Filter {
+	if f.err != nil {
+		return f
+	}
+
+	return Filter{expr: "!(" + f.expr + ")"}
+}
+
+// Group returns the provided Filter wrapped in parentheses.
+func Group(f Filter) Filter {
+	if f.err != nil {
+		return f
+	}
+
+	return Filter{expr: "(" + f.expr + ")"}
+}
+
+// combine joins the provided filters with the provided logical operator.
+// A single filter is returned unchanged.
+func combine(op string, filters []Filter) Filter {
+	if len(filters) <= 0 {
+		return Filter{err: ErrEmptyFilterVals}
+	}
+
+	if len(filters) == 1 {
+		return filters[0]
+	}
+
+	exprs := make([]string, len(filters))
+	for i, f := range filters {
+		if f.err != nil {
+			return f
+		}
+
+		exprs[i] = f.group(op)
+	}
+
+	return Filter{expr: strings.Join(exprs, op), op: op}
+}
+
+// compare returns a Filter comparing the provided field to the provided
+// operand using the provided comparison operator.
+func compare(field, cmp string, val interface{}) Filter {
+	if blank.Is(field) {
+		return Filter{err: ErrEmptyFields}
+	}
+
+	v, err := formatOperand(val)
+	if err != nil {
+		return Filter{err: err}
+	}
+
+	return Filter{expr: field + " " + cmp + " " + v}
+}
+
+// compareList returns a Filter comparing the provided field to the list of
+// provided operands wrapped in the provided brackets.
+func compareList(field, cmp, open, close string, vals []interface{}) Filter {
+	if blank.Is(field) {
+		return Filter{err: ErrEmptyFields}
+	}
+
+	if len(vals) <= 0 {
+		return Filter{err: ErrEmptyFilterVals}
+	}
+
+	list := make([]string, 0, len(vals))
+	for _, val := range vals {
+		vs, err := formatOperands(val)
+		if err != nil {
+			return Filter{err: err}
+		}
+
+		list = append(list, vs...)
+	}
+
+	if len(list) <= 0 {
+		return Filter{err: ErrEmptyFilterVals}
+	}
+
+	return Filter{expr: field + " " + cmp + " " + open + strings.Join(list, ",") + close}
+}
+
+// match returns a Filter matching a string field against the provided pattern.
+// The prefix and suffix wildcards are added to the quoted pattern as requested.
+func match(field, pattern string, prefix, suffix, fold bool) Filter {
+	if blank.Is(field) {
+		return Filter{err: ErrEmptyFields}
+	}
+
+	if blank.Is(pattern) {
+		return Filter{err: ErrEmptyFilterVals}
+	}
+
+	cmp := "="
+	if fold {
+		cmp = "~"
+	}
+
+	v := quote(pattern)
+	if prefix {
+		v = "*" + v
+	}
+	if suffix {
+		v = v + "*"
+	}
+
+	return Filter{expr: field + " " + cmp + " " + v}
+}
+
+// Eq returns a Filter matching results whose field is equal to the provided operand.
+func Eq(field string, val interface{}) Filter {
+	return compare(field, "=", val)
+}
+
+// NotEq returns a Filter matching results whose field is not equal to the provided operand.
+func NotEq(field string, val interface{}) Filter {
+	return compare(field, "!=", val)
+}
+
+// Gt returns a Filter matching results whose field is greater than the provided operand.
+func Gt(field string, val interface{}) Filter {
+	return compare(field, ">", val)
+}
+
+// Gte returns a Filter matching results whose field is greater than or equal to the provided operand.
+func Gte(field string, val interface{}) Filter {
+	return compare(field, ">=", val)
+}
+
+// Lt returns a Filter matching results whose field is less than the provided operand.
+func Lt(field string, val interface{}) Filter {
+	return compare(field, "<", val)
+}
+
+// Lte returns a Filter matching results whose field is less than or equal to the provided operand.
+func Lte(field string, val interface{}) Filter {
+	return compare(field, "<=", val)
+}
+
+// IsNull returns a Filter matching results whose field has no value.
+func IsNull(field string) Filter {
+	return compare(field, "=", nil)
+}
+
+// NotNull returns a Filter matching results whose field has a value.
+func NotNull(field string) Filter {
+	return compare(field, "!=", nil)
+}
+
+// Before returns a Filter matching results whose timestamp field
+// is earlier than the provided time.
+func Before(field string, t time.Time) Filter {
+	return compare(field, "<", t)
+}
+
+// After returns a Filter matching results whose timestamp field
+// is later than the provided time.
+func After(field string, t time.Time) Filter {
+	return compare(field, ">", t)
+}
+
+// Between returns a Filter matching results whose timestamp field is
+// within the provided range, including from and excluding to.
+func Between(field string, from, to time.Time) Filter {
+	return And(compare(field, ">=", from), compare(field, "<", to))
+}
+
+// ContainsAll returns a Filter matching results whose array field contains
+// every provided operand.
+func ContainsAll(field string, vals ...interface{}) Filter {
+	return compareList(field, "=", "[", "]", vals)
+}
+
+// NotContainsAll returns a Filter matching results whose array field does not
+// contain every provided operand.
+func NotContainsAll(field string, vals ...interface{}) Filter {
+	return compareList(field, "!=", "[", "]", vals)
+}
+
+// ContainsAny returns a Filter matching results whose array field contains
+// at least one of the provided operands.
+func ContainsAny(field string, vals ...interface{}) Filter {
+	return compareList(field, "=", "(", ")", vals)
+}
+
+// NotContainsAny returns a Filter matching results whose array field contains
+// none of the provided operands.
+func NotContainsAny(field string, vals ...interface{}) Filter {
+	return compareList(field, "!=", "(", ")", vals)
+}
+
+// ContainsExactly returns a Filter matching results whose array field contains
+// exactly the provided operands and nothing else.
+func ContainsExactly(field string, vals ...interface{}) Filter {
+	return compareList(field, "=", "{", "}", vals)
+}
+
+// HasPrefix returns a Filter matching results whose string field begins
+// with the provided prefix. The match is case insensitive.
+func HasPrefix(field, prefix string) Filter {
+	return match(field, prefix, false, true, true)
+}
+
+// HasSuffix returns a Filter matching results whose string field ends
+// with the provided suffix. The match is case insensitive.
+func HasSuffix(field, suffix string) Filter {
+	return match(field, suffix, true, false, true)
+}
+
+// Contains returns a Filter matching results whose string field contains
+// the provided substring. The match is case insensitive.
+func Contains(field, substr string) Filter {
+	return match(field, substr, true, true, true)
+}
+
+// EqualFold returns a Filter matching results whose string field is equal
+// to the provided string, ignoring case.
+func EqualFold(field, s string) Filter {
+	return match(field, s, false, false, true)
+}
+
+// SetWhere is a functional option used to filter the results from an API
+// call using the provided Filter. SetWhere may be used alongside SetFilter
+// and may be set multiple times in a single API call, in which case every
+// filter must be satisfied.
+//
+// For more information, visit: https://api-docs.igdb.com/#filters
+func SetWhere(f Filter) Option {
+	return func() (apicalypse.Option, error) {
+		if f.err != nil {
+			return nil, f.err
+		}
+
+		if blank.Is(f.expr) {
+			return nil, ErrEmptyFilterVals
+		}
+
+		return apicalypse.Where(f.group(logicalAnd)), nil
+	}
+}
+
+// quote returns the provided string as a quoted Apicalypse string literal,
+// escaping any backslashes and double quotes.
+func quote(s string) string {
+	s = strings.Replace(s, `\`, `\\`, -1)
+	s = strings.Replace(s, `"`, `\"`, -1)
+	return `"` + s + `"`
+}
+
+// formatOperand renders the provided operand as a single Apicalypse value.
+// Strings are quoted and escaped, nil is rendered as null, and numbers and
+// booleans are rendered as is. Enumerated types such as GenderCode are
+// rendered as their underlying number. Times and Timestamps are rendered
+// as Unix timestamps in seconds, while an unset Timestamp is rendered as null.
+func formatOperand(val interface{}) (string, error) {
+	if val == nil {
+		return "null", nil
+	}
+
+	switch v := val.(type) {
+	case string:
+		return quote(v), nil
+	case Reference:
+		return strconv.Itoa(v.ID), nil
+	case time.Time:
+		return strconv.FormatInt(v.Unix(), 10), nil
+	case Timestamp:
+		if !v.IsSet() {
+			return "null", nil
+		}
+		return strconv.FormatInt(v.Unix(), 10), nil
+	}
+
+	rv := reflect.ValueOf(val)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(rv.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(rv.Float(), 'f', -1, 64), nil
+	case reflect.Bool:
+		return strconv.FormatBool(rv.Bool()), nil
+	case reflect.String:
+		return quote(rv.String()), nil
+	}
+
+	return "", errors.Wrapf(ErrInvalidOperand, "cannot format operand of type %T", val)
+}
+
+// formatOperands renders the provided operand as a list of Apicalypse values.
+// Slices are flattened into their elements while any other operand is
+// rendered as a single value.
+func formatOperands(val interface{}) ([]string, error) {
+	if r, ok := val.(References); ok {
+		val = r.IDs()
+	}
+
+	rv := reflect.ValueOf(val)
+	if val == nil || rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		v, err := formatOperand(val)
+		if err != nil {
+			return nil, err
+		}
+
+		return []string{v}, nil
+	}
+
+	vals := make([]string, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		v, err := formatOperand(rv.Index(i).Interface())
+		if err != nil {
+			return nil, err
+		}
+
+		vals[i] = v
+	}
+
+	return vals, nil
+}
@@ -0,0 +1,112 @@
+package igdb
+
+import (
+	"github.com/pkg/errors"
+	"testing"
+	"time"
+)
+
+func TestFilter_String(t *testing.T) {
+	tests := []struct {
+		name    string
+		filter  Filter
+		want    string
+		wantErr error
+	}{
+		{"Equals int", Eq("platforms", 48), "platforms = 48", nil},
+		{"Not equals", NotEq("category", 0), "category != 0", nil},
+		{"Greater than float", Gt("rating", 80.5), "rating > 80.5", nil},
+		{"Greater than or equal", Gte("rating_count", 50), "rating_count >= 50", nil},
+		{"Less than", Lt("hypes", int64(100)), "hypes < 100", nil},
+		{"Less than or equal", Lte("hypes", uint(100)), "hypes <= 100", nil},
+		{"Boolean operand", Eq("checksum_valid", true), "checksum_valid = true", nil},
+		{"Enum operand", Eq("gender", GenderFemale), "gender = 2", nil},
+		{"Reference operand", Eq("cover", Reference{ID: 5}), "cover = 5", nil},
+		{"Time operand", Gte("first_release_date", time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)), "first_release_date >= 1577836800", nil},
+		{"Timestamp operand", Lt("created_at", NewTimestamp(time.Unix(1500000000, 0))), "created_at < 1500000000", nil},
+		{"Unset timestamp operand", Eq("dob", Timestamp{}), "dob = null", nil},
+		{"Before", Before("date", time.Unix(100, 0)), "date < 100", nil},
+		{"After", After("date", time.Unix(100, 0)), "date > 100", nil},
+		{"Between", Between("date", time.Unix(100, 0), time.Unix(200, 0)), "date >= 100 & date < 200", nil},
+		{"Null operand", IsNull("cover"), "cover = null", nil},
+		{"Not null operand", NotNull("cover"), "cover != null", nil},
+		{"String operand", Eq("name", "Halo"), `name = "Halo"`, nil},
+		{"Escaped string operand", Eq("name", `The "Best" \ Game`), `name = "The \"Best\" \\ Game"`, nil},
+		{"Contains all", ContainsAll("genres", 4, 5), "genres = [4,5]", nil},
+		{"Contains all slice", ContainsAll("genres", []int{4, 5}), "genres = [4,5]", nil},
+		{"Not contains all", NotContainsAll("genres", 4), "genres != [4]", nil},
+		{"Contains any", ContainsAny("platforms", 48, 49), "platforms = (48,49)", nil},
+		{"Not contains any", NotContainsAny("platforms", References{{ID: 48}, {ID: 49}}), "platforms != (48,49)", nil},
+		{"Contains exactly", ContainsExactly("platforms", 6), "platforms = {6}", nil},
+		{"Contains strings", ContainsAny("tags", "a", `"b"`), `tags = ("a","\"b\"")`, nil},
+		{"Has prefix", HasPrefix("name", "Zelda"), `name ~ "Zelda"*`, nil},
+		{"Has suffix", HasSuffix("name", "Zelda"), `name ~ *"Zelda"`, nil},
+		{"Contains", Contains("name", "Zelda"), `name ~ *"Zelda"*`, nil},
+		{"Equal fold", EqualFold("name", "Zelda"), `name ~ "Zelda"`, nil},
+		{"And", And(Eq("a", 1), Eq("b", 2), Eq("c", 3)), "a = 1 & b = 2 & c = 3", nil},
+		{"Or", Or(Eq("a", 1), Eq("b", 2)), "a = 1 | b = 2", nil},
+		{"Single and", And(Eq("a", 1)), "a = 1", nil},
+		{"Or of ands", Or(And(Gt("rating", 80), Gt("rating_count", 50)), Gt("hypes", 100)), "(rating > 80 & rating_count > 50) | hypes > 100", nil},
+		{"And of ors", And(Or(Eq("a", 1), Eq("b", 2)), Eq("c", 3)), "(a = 1 | b = 2) & c = 3", nil},
+		{"Nested same operator", And(And(Eq("a", 1), Eq("b", 2)), Eq("c", 3)), "a = 1 & b = 2 & c = 3", nil},
+		{"Method chaining", Eq("a", 1).Or(Eq("b", 2)).And(Eq("c", 3)), "(a = 1 | b = 2) & c = 3", nil},
+		{"Not", Not(Eq("a", 1)), "!(a = 1)", nil},
+		{"Not method", Or(Eq("a", 1), Eq("b", 2)).Not(), "!(a = 1 | b = 2)", nil},
+		{"Group", Group(Eq("a", 1)), "(a = 1)", nil},
+		{"Empty field", Eq("", 1), "", ErrEmptyFields},
+		{"Blank field", Eq("  ", 1), "", ErrEmptyFields},
+		{"Empty list", ContainsAll("genres"), "", ErrEmptyFilterVals},
+		{"Empty slice", ContainsAll("genres", []int{}), "", ErrEmptyFilterVals},
+		{"Empty pattern", HasPrefix("name", ""), "", ErrEmptyFilterVals},
+		{"Unsupported operand", Eq("a", struct{}{}), "", ErrInvalidOperand},
+		{"Unsupported list operand", ContainsAny("a", 1, map[int]int{}), "", ErrInvalidOperand},
+		{"Empty and", And(), "", ErrEmptyFilterVals},
+		{"Error propagates through and", And(Eq("a", 1), Eq("", 2)), "", ErrEmptyFields},
+		{"Error propagates through not", Not(Eq("", 2)), "", ErrEmptyFields},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if errors.Cause(test.filter.Err()) != test.wantErr {
+				t.Fatalf("got: <%v>, want: <%v>", errors.Cause(test.filter.Err()), test.wantErr)
+			}
+
+			if test.filter.String() != test.want {
+				t.Errorf("got: <%v>, want: <%v>", test.filter.String(), test.want)
+			}
+		})
+	}
+}
+
+func TestSetWhere(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    []Option
+		want    string
+		wantErr error
+	}{
+		{"Single filter", []Option{SetWhere(Eq("platforms", 48))}, "where platforms = 48; ", nil},
+		{"Or filter", []Option{SetWhere(Or(Eq("platforms", 48), Eq("platforms", 49)))}, "where (platforms = 48 | platforms = 49); ", nil},
+		{"And filter", []Option{SetWhere(And(Eq("a", 1), Eq("b", 2)))}, "where a = 1 & b = 2; ", nil},
+		{
+			"Combined with SetFilter",
+			[]Option{SetFilter("rating", OpGreaterThan, "80"), SetWhere(Or(Eq("a", 1), Eq("b", 2)))},
+			"where (a = 1 | b = 2) & rating > 80; ",
+			nil,
+		},
+		{"Composed", []Option{ComposeOptions(SetWhere(Eq("a", 1)), SetLimit(5))}, "where a = 1; limit 5; ", nil},
+		{"Zero filter", []Option{SetWhere(Filter{})}, "", ErrEmptyFilterVals},
+		{"Invalid filter", []Option{SetWhere(Eq("", 1))}, "", ErrEmptyFields},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			qry, err := renderQuery(test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Fatalf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if qry != test.want {
+				t.Errorf("got: <%v>, want: <%v>", qry, test.want)
+			}
+		})
+	}
+}
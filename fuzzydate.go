@@ -0,0 +1,219 @@
+package igdb
+
+import (
+	"strconv"
+	"time"
+)
+
+// DatePrecision is how precisely a FuzzyDate is known.
+type DatePrecision int
+
+// Available DatePrecisions, from least to most precise.
+const (
+	PrecisionTBD DatePrecision = iota
+	PrecisionYear
+	PrecisionQuarter
+	PrecisionMonth
+	PrecisionDay
+)
+
+// String returns the name of the DatePrecision.
+func (p DatePrecision) String() string {
+	switch p {
+	case PrecisionTBD:
+		return "TBD"
+	case PrecisionYear:
+		return "Year"
+	case PrecisionQuarter:
+		return "Quarter"
+	case PrecisionMonth:
+		return "Month"
+	case PrecisionDay:
+		return "Day"
+	}
+
+	return "DatePrecision(" + strconv.Itoa(int(p)) + ")"
+}
+
+// FuzzyDate is a date known only to a certain precision, such as the release
+// date of a game announced for the third quarter of a year. A FuzzyDate spans
+// every day it may fall on, from Start up to End. Fields beyond the precision
+// of the FuzzyDate are zero. The zero FuzzyDate is to be determined.
+type FuzzyDate struct {
+	Precision DatePrecision
+	Year      int
+	Quarter   int
+	Month     time.Month
+	Day       int
+}
+
+// NewFuzzyDate returns the FuzzyDate described by the provided DateCategory,
+// Timestamp, year, and month, as found in ReleaseDate and
+// PlatformVersionReleaseDate. The year and month take precedence over the
+// Timestamp when set, except for an exact date, whose year, month, and day
+// are all taken from the Timestamp when it is set. If the fields do not
+// support the precision of the DateCategory, the FuzzyDate is as precise as
+// the fields allow.
+func NewFuzzyDate(cat DateCategory, date Timestamp, y, m int) FuzzyDate {
+	t := date.Time()
+	if cat == DateYYYYMMMMDD && date.IsSet() {
+		return FuzzyDate{Precision: PrecisionDay, Year: t.Year(), Quarter: (int(t.Month())-1)/3 + 1, Month: t.Month(), Day: t.Day()}
+	}
+
+	if y == 0 && date.IsSet() {
+		y = t.Year()
+	}
+	if m == 0 && date.IsSet() {
+		m = int(t.Month())
+	}
+
+	if y == 0 || cat == DateTBD {
+		return FuzzyDate{}
+	}
+
+	switch cat {
+	case DateYYYYQ1, DateYYYYQ2, DateYYYYQ3, DateYYYYQ4:
+		return FuzzyDate{Precision: PrecisionQuarter, Year: y, Quarter: int(cat-DateYYYYQ1) + 1}
+	case DateYYYY:
+		return FuzzyDate{Precision: PrecisionYear, Year: y}
+	}
+
+	if m < 1 || m > 12 {
+		return FuzzyDate{Precision: PrecisionYear, Year: y}
+	}
+
+	return FuzzyDate{Precision: PrecisionMonth, Year: y, Quarter: (m-1)/3 + 1, Month: time.Month(m)}
+}
+
+// FuzzyDate returns the release date as a FuzzyDate of
+// the precision indicated by its Category.
+func (r *ReleaseDate) FuzzyDate() FuzzyDate {
+	return NewFuzzyDate(r.Category, r.Date, r.Y, r.M)
+}
+
+// FuzzyDate returns the release date as a FuzzyDate of
+// the precision indicated by its Category.
+func (p *PlatformVersionReleaseDate) FuzzyDate() FuzzyDate {
+	return NewFuzzyDate(p.Category, p.Date, p.Y, p.M)
+}
+
+// IsTBD returns true if the FuzzyDate is to be determined.
+func (d FuzzyDate) IsTBD() bool {
+	return d.Precision == PrecisionTBD
+}
+
+// Start returns the first instant the FuzzyDate may fall on, in UTC.
+// If the FuzzyDate is to be determined, the zero time.Time is returned.
+func (d FuzzyDate) Start() time.Time {
+	switch d.Precision {
+	case PrecisionYear:
+		return time.Date(d.Year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	case PrecisionQuarter:
+		return time.Date(d.Year, time.Month(d.Quarter*3-2), 1, 0, 0, 0, 0, time.UTC)
+	case PrecisionMonth:
+		return time.Date(d.Year, d.Month, 1, 0, 0, 0, 0, time.UTC)
+	case PrecisionDay:
+		return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, time.UTC)
+	}
+
+	return time.Time{}
+}
+
+// End returns the first instant after the last day the FuzzyDate may fall
+// on, in UTC. If the FuzzyDate is to be determined, the zero time.Time
+// is returned.
+func (d FuzzyDate) End() time.Time {
+	start := d.Start()
+
+	switch d.Precision {
+	case PrecisionYear:
+		return start.AddDate(1, 0, 0)
+	case PrecisionQuarter:
+		return start.AddDate(0, 3, 0)
+	case PrecisionMonth:
+		return start.AddDate(0, 1, 0)
+	case PrecisionDay:
+		return start.AddDate(0, 0, 1)
+	}
+
+	return time.Time{}
+}
+
+// Overlaps returns true if the FuzzyDate may fall within the provided range,
+// including from and excluding to. For example, a FuzzyDate in the fourth
+// quarter of 2024 overlaps October 2024 while one in the third quarter does not.
+// A FuzzyDate to be determined overlaps no range.
+func (d FuzzyDate) Overlaps(from, to time.Time) bool {
+	if d.IsTBD() {
+		return false
+	}
+
+	return d.Start().Before(to) && d.End().After(from)
+}
+
+// Within returns true if the FuzzyDate certainly falls within the provided
+// range, including from and excluding to. A FuzzyDate to be determined is
+// within no range.
+func (d FuzzyDate) Within(from, to time.Time) bool {
+	if d.IsTBD() {
+		return false
+	}
+
+	return !d.Start().Before(from) && !d.End().After(to)
+}
+
+// Compare returns -1, 0, or +1 depending on whether the FuzzyDate sorts
+// before, together with, or after the provided FuzzyDate. FuzzyDates are
+// sorted by the first day they may fall on, then by the last, so a precise
+// date sorts before a vaguer one starting on the same day. FuzzyDates to be
+// determined sort after every other FuzzyDate.
+func (d FuzzyDate) Compare(o FuzzyDate) int {
+	switch {
+	case d.IsTBD() && o.IsTBD():
+		return 0
+	case d.IsTBD():
+		return 1
+	case o.IsTBD():
+		return -1
+	}
+
+	ds, ost := d.Start(), o.Start()
+	switch {
+	case ds.Before(ost):
+		return -1
+	case ds.After(ost):
+		return 1
+	}
+
+	de, oe := d.End(), o.End()
+	switch {
+	case de.Before(oe):
+		return -1
+	case de.After(oe):
+		return 1
+	}
+
+	return 0
+}
+
+// Before returns true if the FuzzyDate sorts before the provided FuzzyDate.
+func (d FuzzyDate) Before(o FuzzyDate) bool {
+	return d.Compare(o) < 0
+}
+
+// String returns the FuzzyDate formatted to its precision, such as
+// "Oct 15, 2024", "Oct 2024", "Q3 2024", "2024", or "TBA".
+func (d FuzzyDate) String() string {
+	switch d.Precision {
+	case PrecisionYear:
+		return strconv.Itoa(d.Year)
+	case PrecisionQuarter:
+		return "Q" + strconv.Itoa(d.Quarter) + " " + strconv.Itoa(d.Year)
+	case PrecisionMonth:
+		return d.Start().Format("Jan 2006")
+	case PrecisionDay:
+		return d.Start().Format("Jan 2, 2006")
+	}
+
+	return "TBA"
+}
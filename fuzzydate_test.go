@@ -0,0 +1,150 @@
+package igdb
+
+import (
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestNewFuzzyDate(t *testing.T) {
+	oct15 := NewTimestamp(time.Date(2024, time.October, 15, 0, 0, 0, 0, time.UTC))
+	sep30 := NewTimestamp(time.Date(2024, time.September, 30, 0, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name      string
+		cat       DateCategory
+		date      Timestamp
+		y, m      int
+		want      FuzzyDate
+		wantStr   string
+		wantStart time.Time
+		wantEnd   time.Time
+	}{
+		{
+			"Exact date", DateYYYYMMMMDD, oct15, 2024, 10,
+			FuzzyDate{Precision: PrecisionDay, Year: 2024, Quarter: 4, Month: time.October, Day: 15},
+			"Oct 15, 2024", oct15.Time(), oct15.Time().AddDate(0, 0, 1),
+		},
+		{
+			"Exact date with disagreeing fields", DateYYYYMMMMDD, oct15, 2023, 2,
+			FuzzyDate{Precision: PrecisionDay, Year: 2024, Quarter: 4, Month: time.October, Day: 15},
+			"Oct 15, 2024", oct15.Time(), oct15.Time().AddDate(0, 0, 1),
+		},
+		{
+			"Month", DateYYYYMMMM, oct15, 2024, 10,
+			FuzzyDate{Precision: PrecisionMonth, Year: 2024, Quarter: 4, Month: time.October},
+			"Oct 2024", time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			"Quarter", DateYYYYQ3, sep30, 2024, 9,
+			FuzzyDate{Precision: PrecisionQuarter, Year: 2024, Quarter: 3},
+			"Q3 2024", time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			"Year", DateYYYY, Timestamp{}, 2025, 0,
+			FuzzyDate{Precision: PrecisionYear, Year: 2025},
+			"2025", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{"TBD", DateTBD, oct15, 2024, 10, FuzzyDate{}, "TBA", time.Time{}, time.Time{}},
+		{"No year", DateYYYY, Timestamp{}, 0, 0, FuzzyDate{}, "TBA", time.Time{}, time.Time{}},
+		{
+			"Year from timestamp", DateYYYYQ4, oct15, 0, 0,
+			FuzzyDate{Precision: PrecisionQuarter, Year: 2024, Quarter: 4},
+			"Q4 2024", time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			"Exact date without timestamp", DateYYYYMMMMDD, Timestamp{}, 2024, 2,
+			FuzzyDate{Precision: PrecisionMonth, Year: 2024, Quarter: 1, Month: time.February},
+			"Feb 2024", time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			"Month without month", DateYYYYMMMM, Timestamp{}, 2024, 0,
+			FuzzyDate{Precision: PrecisionYear, Year: 2024},
+			"2024", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			d := NewFuzzyDate(test.cat, test.date, test.y, test.m)
+			if d != test.want {
+				t.Fatalf("got: <%+v>, want: <%+v>", d, test.want)
+			}
+
+			if d.String() != test.wantStr {
+				t.Errorf("got: <%v>, want: <%v>", d.String(), test.wantStr)
+			}
+
+			if !d.Start().Equal(test.wantStart) || !d.End().Equal(test.wantEnd) {
+				t.Errorf("got: <%v - %v>, want: <%v - %v>", d.Start(), d.End(), test.wantStart, test.wantEnd)
+			}
+		})
+	}
+}
+
+func TestReleaseDate_FuzzyDate(t *testing.T) {
+	r := &ReleaseDate{Category: DateYYYYQ2, Y: 2023, M: 6}
+	if got := r.FuzzyDate().String(); got != "Q2 2023" {
+		t.Errorf("got: <%v>, want: <%v>", got, "Q2 2023")
+	}
+
+	p := &PlatformVersionReleaseDate{Category: DateYYYYMMMM, Y: 2023, M: 6}
+	if got := p.FuzzyDate().String(); got != "Jun 2023" {
+		t.Errorf("got: <%v>, want: <%v>", got, "Jun 2023")
+	}
+}
+
+func TestFuzzyDate_Overlaps(t *testing.T) {
+	octStart := time.Date(2024, time.October, 1, 0, 0, 0, 0, time.UTC)
+	octEnd := time.Date(2024, time.November, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		date        FuzzyDate
+		wantOverlap bool
+		wantWithin  bool
+	}{
+		{"Day inside", FuzzyDate{Precision: PrecisionDay, Year: 2024, Quarter: 4, Month: time.October, Day: 31}, true, true},
+		{"Day outside", FuzzyDate{Precision: PrecisionDay, Year: 2024, Quarter: 4, Month: time.November, Day: 1}, false, false},
+		{"Same month", FuzzyDate{Precision: PrecisionMonth, Year: 2024, Quarter: 4, Month: time.October}, true, true},
+		{"Fourth quarter", FuzzyDate{Precision: PrecisionQuarter, Year: 2024, Quarter: 4}, true, false},
+		{"Third quarter", FuzzyDate{Precision: PrecisionQuarter, Year: 2024, Quarter: 3}, false, false},
+		{"Same year", FuzzyDate{Precision: PrecisionYear, Year: 2024}, true, false},
+		{"Other year", FuzzyDate{Precision: PrecisionYear, Year: 2023}, false, false},
+		{"TBD", FuzzyDate{}, false, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.date.Overlaps(octStart, octEnd); got != test.wantOverlap {
+				t.Errorf("got: <%v>, want: <%v>", got, test.wantOverlap)
+			}
+
+			if got := test.date.Within(octStart, octEnd); got != test.wantWithin {
+				t.Errorf("got: <%v>, want: <%v>", got, test.wantWithin)
+			}
+		})
+	}
+}
+
+func TestFuzzyDate_Compare(t *testing.T) {
+	jul1 := FuzzyDate{Precision: PrecisionDay, Year: 2024, Quarter: 3, Month: time.July, Day: 1}
+	jul15 := FuzzyDate{Precision: PrecisionDay, Year: 2024, Quarter: 3, Month: time.July, Day: 15}
+	jul := FuzzyDate{Precision: PrecisionMonth, Year: 2024, Quarter: 3, Month: time.July}
+	q3 := FuzzyDate{Precision: PrecisionQuarter, Year: 2024, Quarter: 3}
+	y2024 := FuzzyDate{Precision: PrecisionYear, Year: 2024}
+	y2025 := FuzzyDate{Precision: PrecisionYear, Year: 2025}
+	tbd := FuzzyDate{}
+
+	dates := []FuzzyDate{tbd, y2025, q3, jul15, y2024, jul, jul1}
+	sort.Slice(dates, func(i, j int) bool { return dates[i].Before(dates[j]) })
+
+	want := []FuzzyDate{y2024, jul1, jul, q3, jul15, y2025, tbd}
+	for i := range want {
+		if dates[i] != want[i] {
+			t.Fatalf("got: <%v>, want: <%v>", dates, want)
+		}
+	}
+
+	if jul.Compare(jul) != 0 || tbd.Compare(tbd) != 0 {
+		t.Errorf("got: <%v, %v>, want: <0, 0>", jul.Compare(jul), tbd.Compare(tbd))
+	}
+}
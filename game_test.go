@@ -1,13 +1,16 @@
 package igdb
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/pkg/errors"
 	"io/ioutil"
 	"net/http"
 	"reflect"
+	"sync"
 	"testing"
+	"time"
 )
 
 const (
@@ -38,7 +41,7 @@ func TestGameService_Get(t *testing.T) {
 	}{
 		{"Valid response", testGameGet, 7346, []Option{SetFields("name")}, init[0], nil},
 		{"Invalid ID", testFileEmpty, -1, nil, nil, ErrNegativeID},
-		{"Empty response", testFileEmpty, 7346, nil, nil, errInvalidJSON},
+		{"Empty response", testFileEmpty, 7346, nil, nil, ErrInvalidJSON},
 		{"Invalid option", testFileEmpty, 7346, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
 		{"No results", testFileEmptyArray, 0, nil, nil, ErrNoResults},
 	}
@@ -85,7 +88,7 @@ func TestGameService_List(t *testing.T) {
 		{"Valid response", testGameList, []int{105842, 32478, 98774, 104945, 69530}, []Option{SetLimit(5)}, init, nil},
 		{"Zero IDs", testFileEmpty, nil, nil, nil, ErrEmptyIDs},
 		{"Invalid ID", testFileEmpty, []int{-500}, nil, nil, ErrNegativeID},
-		{"Empty response", testFileEmpty, []int{105842, 32478, 98774, 104945, 69530}, nil, nil, errInvalidJSON},
+		{"Empty response", testFileEmpty, []int{105842, 32478, 98774, 104945, 69530}, nil, nil, ErrInvalidJSON},
 		{"Invalid option", testFileEmpty, []int{105842, 32478, 98774, 104945, 69530}, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
 		{"No results", testFileEmptyArray, []int{0, 9999999}, nil, nil, ErrNoResults},
 	}
@@ -129,7 +132,7 @@ func TestGameService_Index(t *testing.T) {
 		wantErr   error
 	}{
 		{"Valid response", testGameList, []Option{SetLimit(5)}, init, nil},
-		{"Empty response", testFileEmpty, nil, nil, errInvalidJSON},
+		{"Empty response", testFileEmpty, nil, nil, ErrInvalidJSON},
 		{"Invalid option", testFileEmpty, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
 		{"No results", testFileEmptyArray, nil, nil, ErrNoResults},
 	}
@@ -175,7 +178,7 @@ func TestGameService_Search(t *testing.T) {
 	}{
 		{"Valid response", testGameSearch, "mario", []Option{SetLimit(5)}, init, nil},
 		{"Empty query", testFileEmpty, "", []Option{SetLimit(5)}, nil, ErrEmptyQry},
-		{"Empty response", testFileEmpty, "mario", nil, nil, errInvalidJSON},
+		{"Empty response", testFileEmpty, "mario", nil, nil, ErrInvalidJSON},
 		{"Invalid option", testFileEmpty, "mario", []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
 		{"No results", testFileEmptyArray, "non-existent entry", nil, nil, ErrNoResults},
 	}
@@ -208,7 +211,7 @@ func TestGameService_Count(t *testing.T) {
 		wantErr   error
 	}{
 		{"Happy path", `{"count": 100}`, []Option{SetFilter("popularity", OpGreaterThan, "75")}, 100, nil},
-		{"Empty response", "", nil, 0, errInvalidJSON},
+		{"Empty response", "", nil, 0, ErrInvalidJSON},
 		{"Invalid option", "", []Option{SetLimit(-100)}, 0, ErrOutOfRange},
 		{"No results", "[]", nil, 0, ErrNoResults},
 	}
@@ -240,7 +243,7 @@ func TestGameService_Fields(t *testing.T) {
 		{"Happy path", `["name", "slug", "url"]`, []string{"url", "slug", "name"}, nil},
 		{"Dot operator", `["logo.url", "background.id"]`, []string{"background.id", "logo.url"}, nil},
 		{"Asterisk", `["*"]`, []string{"*"}, nil},
-		{"Empty response", "", nil, errInvalidJSON},
+		{"Empty response", "", nil, ErrInvalidJSON},
 		{"No results", "[]", nil, nil},
 	}
 
@@ -349,3 +352,82 @@ func ExampleGameService_Fields() {
 
 	fmt.Println("List of available fields for the IGDB Game object: ", fl)
 }
+
+func ExampleGameService_SearchContext() {
+	c := NewClient("YOUR_API_KEY", nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	g, err := c.Games.SearchContext(ctx, "mario", SetFields("name"), SetLimit(5))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println("IGDB entries found within 5 seconds for the query 'mario'")
+	for _, v := range g {
+		fmt.Println(*v)
+	}
+}
+
+func ExampleGameService_Iterate() {
+	c := NewClient("YOUR_API_KEY", nil)
+
+	it := c.Games.Iterate(
+		context.Background(),
+		SetFields("name"),
+		SetFilter("platforms", OpEquals, "48"),
+	).Prefetch()
+
+	for it.Next() {
+		fmt.Println(it.Value().Name)
+	}
+
+	if err := it.Err(); err != nil {
+		fmt.Println(err)
+		return
+	}
+}
+
+func ExampleGameService_Scan() {
+	c := NewClient("YOUR_API_KEY", nil)
+
+	// Resume from the cursor saved by a previous scan, or start from 0.
+	cursor := 0
+
+	it := c.Games.Scan(context.Background(), cursor, SetFields("name"), SetLimit(500))
+	for it.Next() {
+		fmt.Println(it.Value().Name)
+	}
+
+	if err := it.Err(); err != nil {
+		fmt.Println(err)
+		fmt.Println("resume the scan from cursor", it.Cursor())
+		return
+	}
+}
+
+func ExampleGameService_Loader() {
+	c := NewClient("YOUR_API_KEY", nil)
+
+	l := c.Games.Loader(context.Background(), SetFields("name"))
+
+	// Lookups made at the same time are retrieved with a single API call.
+	var wg sync.WaitGroup
+	for _, id := range []int{1942, 1020, 732} {
+		wg.Add(1)
+		go func(id int) {
+			defer wg.Done()
+
+			g, err := l.Load(id)
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+
+			fmt.Println(g.Name)
+		}(id)
+	}
+	wg.Wait()
+}
@@ -1,6 +1,6 @@
 module github.com/Henry-Sarabia/igdb
 
-go 1.13
+go 1.18
 
 require (
 	github.com/Henry-Sarabia/apicalypse v1.0.2
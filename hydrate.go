@@ -0,0 +1,421 @@
+package igdb
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// Relation is a set of objects referenced by a Game that can be retrieved
+// along with the Game itself. Relations are combined with the | operator.
+type Relation int
+
+// Available Relations of a Game.
+const (
+	RelationAgeRatings Relation = 1 << iota
+	RelationAlternativeNames
+	RelationArtworks
+	RelationCollection
+	RelationCompanies
+	RelationCover
+	RelationFranchises
+	RelationGameEngines
+	RelationGameModes
+	RelationGenres
+	RelationKeywords
+	RelationPlatforms
+	RelationPlayerPerspectives
+	RelationReleaseDates
+	RelationScreenshots
+	RelationThemes
+	RelationTimeToBeat
+	RelationVideos
+	RelationWebsites
+
+	// RelationAll combines every available Relation.
+	RelationAll Relation = 1<<iota - 1
+)
+
+// GameDetails is a Game along with the objects it references. Only the
+// Relations requested when the GameDetails was retrieved are filled in.
+type GameDetails struct {
+	Game               *Game
+	AgeRatings         []*AgeRating
+	AlternativeNames   []*AlternativeName
+	Artworks           []*Artwork
+	Collection         *Collection
+	Cover              *Cover
+	Franchises         []*Franchise
+	GameEngines        []*GameEngine
+	GameModes          []*GameMode
+	Genres             []*Genre
+	Keywords           []*Keyword
+	Platforms          []*Platform
+	PlayerPerspectives []*PlayerPerspective
+	ReleaseDates       []*ReleaseDate
+	Screenshots        []*Screenshot
+	Themes             []*Theme
+	TimeToBeat         *TimeToBeat
+	Videos             []*GameVideo
+	Websites           []*Website
+
+	// InvolvedCompanies and the companies split by their involvement in the
+	// Game are filled in by RelationCompanies. A company involved in more
+	// than one way appears in more than one list.
+	InvolvedCompanies []*InvolvedCompany
+	Developers        []*Company
+	Publishers        []*Company
+	Porters           []*Company
+	Supporters        []*Company
+}
+
+// relation describes how to retrieve the objects of a single Relation and
+// where to store them in a GameDetails.
+type relation struct {
+	refs func(g *Game) References
+	// load retrieves the objects identified by the provided IDs and
+	// returns a function assigning the object identified by an ID to
+	// a GameDetails, if it was found.
+	load func(ctx context.Context, c *Client, ids []int) (func(d *GameDetails, id int), error)
+}
+
+// newRelation returns a relation retrieving the objects referenced by the
+// provided refs function with the Loader returned by the provided loader
+// function, and storing them with the provided assign function.
+func newRelation[T any](refs func(g *Game) References, loader func(ctx context.Context, c *Client) *Loader[T], assign func(d *GameDetails, v *T)) relation {
+	return relation{
+		refs: refs,
+		load: func(ctx context.Context, c *Client, ids []int) (func(d *GameDetails, id int), error) {
+			found, err := loader(ctx, c).loadMap(ids)
+			if err != nil {
+				return nil, err
+			}
+
+			return func(d *GameDetails, id int) {
+				if v, ok := found[id]; ok {
+					assign(d, v)
+				}
+			}, nil
+		},
+	}
+}
+
+// one returns the provided Reference as References, omitting a null Reference.
+func one(ref Reference) References {
+	if ref.ID == 0 {
+		return nil
+	}
+
+	return References{ref}
+}
+
+// relations maps every Relation other than RelationCompanies to the
+// description of how to retrieve it.
+var relations = map[Relation]relation{
+	RelationAgeRatings: newRelation(
+		func(g *Game) References { return g.AgeRatings },
+		func(ctx context.Context, c *Client) *Loader[AgeRating] {
+			return c.AgeRatings.Loader(ctx, SetFields("*"))
+		},
+		func(d *GameDetails, v *AgeRating) { d.AgeRatings = append(d.AgeRatings, v) },
+	),
+	RelationAlternativeNames: newRelation(
+		func(g *Game) References { return g.AlternativeNames },
+		func(ctx context.Context, c *Client) *Loader[AlternativeName] {
+			return c.AlternativeNames.Loader(ctx, SetFields("*"))
+		},
+		func(d *GameDetails, v *AlternativeName) { d.AlternativeNames = append(d.AlternativeNames, v) },
+	),
+	RelationArtworks: newRelation(
+		func(g *Game) References { return g.Artworks },
+		func(ctx context.Context, c *Client) *Loader[Artwork] { return c.Artworks.Loader(ctx, SetFields("*")) },
+		func(d *GameDetails, v *Artwork) { d.Artworks = append(d.Artworks, v) },
+	),
+	RelationCollection: newRelation(
+		func(g *Game) References { return one(g.Collection) },
+		func(ctx context.Context, c *Client) *Loader[Collection] {
+			return c.Collections.Loader(ctx, SetFields("*"))
+		},
+		func(d *GameDetails, v *Collection) { d.Collection = v },
+	),
+	RelationCover: newRelation(
+		func(g *Game) References { return one(g.Cover) },
+		func(ctx context.Context, c *Client) *Loader[Cover] { return c.Covers.Loader(ctx, SetFields("*")) },
+		func(d *GameDetails, v *Cover) { d.Cover = v },
+	),
+	RelationFranchises: newRelation(
+		func(g *Game) References {
+			refs := one(g.Franchise)
+			for _, ref := range g.Franchises {
+				if ref.ID != g.Franchise.ID {
+					refs = append(refs, ref)
+				}
+			}
+			return refs
+		},
+		func(ctx context.Context, c *Client) *Loader[Franchise] {
+			return c.Franchises.Loader(ctx, SetFields("*"))
+		},
+		func(d *GameDetails, v *Franchise) { d.Franchises = append(d.Franchises, v) },
+	),
+	RelationGameEngines: newRelation(
+		func(g *Game) References { return g.GameEngines },
+		func(ctx context.Context, c *Client) *Loader[GameEngine] {
+			return c.GameEngines.Loader(ctx, SetFields("*"))
+		},
+		func(d *GameDetails, v *GameEngine) { d.GameEngines = append(d.GameEngines, v) },
+	),
+	RelationGameModes: newRelation(
+		func(g *Game) References { return g.GameModes },
+		func(ctx context.Context, c *Client) *Loader[GameMode] { return c.GameModes.Loader(ctx, SetFields("*")) },
+		func(d *GameDetails, v *GameMode) { d.GameModes = append(d.GameModes, v) },
+	),
+	RelationGenres: newRelation(
+		func(g *Game) References { return g.Genres },
+		func(ctx context.Context, c *Client) *Loader[Genre] { return c.Genres.Loader(ctx, SetFields("*")) },
+		func(d *GameDetails, v *Genre) { d.Genres = append(d.Genres, v) },
+	),
+	RelationKeywords: newRelation(
+		func(g *Game) References { return g.Keywords },
+		func(ctx context.Context, c *Client) *Loader[Keyword] { return c.Keywords.Loader(ctx, SetFields("*")) },
+		func(d *GameDetails, v *Keyword) { d.Keywords = append(d.Keywords, v) },
+	),
+	RelationPlatforms: newRelation(
+		func(g *Game) References { return g.Platforms },
+		func(ctx context.Context, c *Client) *Loader[Platform] { return c.Platforms.Loader(ctx, SetFields("*")) },
+		func(d *GameDetails, v *Platform) { d.Platforms = append(d.Platforms, v) },
+	),
+	RelationPlayerPerspectives: newRelation(
+		func(g *Game) References { return g.PlayerPerspectives },
+		func(ctx context.Context, c *Client) *Loader[PlayerPerspective] {
+			return c.PlayerPerspectives.Loader(ctx, SetFields("*"))
+		},
+		func(d *GameDetails, v *PlayerPerspective) { d.PlayerPerspectives = append(d.PlayerPerspectives, v) },
+	),
+	RelationReleaseDates: newRelation(
+		func(g *Game) References { return g.ReleaseDates },
+		func(ctx context.Context, c *Client) *Loader[ReleaseDate] {
+			return c.ReleaseDates.Loader(ctx, SetFields("*"))
+		},
+		func(d *GameDetails, v *ReleaseDate) { d.ReleaseDates = append(d.ReleaseDates, v) },
+	),
+	RelationScreenshots: newRelation(
+		func(g *Game) References { return g.Screenshots },
+		func(ctx context.Context, c *Client) *Loader[Screenshot] {
+			return c.Screenshots.Loader(ctx, SetFields("*"))
+		},
+		func(d *GameDetails, v *Screenshot) { d.Screenshots = append(d.Screenshots, v) },
+	),
+	RelationThemes: newRelation(
+		func(g *Game) References { return g.Themes },
+		func(ctx context.Context, c *Client) *Loader[Theme] { return c.Themes.Loader(ctx, SetFields("*")) },
+		func(d *GameDetails, v *Theme) { d.Themes = append(d.Themes, v) },
+	),
+	RelationTimeToBeat: newRelation(
+		func(g *Game) References { return one(g.TimeToBeat) },
+		func(ctx context.Context, c *Client) *Loader[TimeToBeat] {
+			return c.TimeToBeats.Loader(ctx, SetFields("*"))
+		},
+		func(d *GameDetails, v *TimeToBeat) { d.TimeToBeat = v },
+	),
+	RelationVideos: newRelation(
+		func(g *Game) References { return g.Videos },
+		func(ctx context.Context, c *Client) *Loader[GameVideo] {
+			return c.GameVideos.Loader(ctx, SetFields("*"))
+		},
+		func(d *GameDetails, v *GameVideo) { d.Videos = append(d.Videos, v) },
+	),
+	RelationWebsites: newRelation(
+		func(g *Game) References { return g.Websites },
+		func(ctx context.Context, c *Client) *Loader[Website] { return c.Websites.Loader(ctx, SetFields("*")) },
+		func(d *GameDetails, v *Website) { d.Websites = append(d.Websites, v) },
+	),
+}
+
+// companies describes how to retrieve the InvolvedCompanies of a Game, the
+// first of the two steps of RelationCompanies.
+var companies = newRelation(
+	func(g *Game) References { return g.InvolvedCompanies },
+	func(ctx context.Context, c *Client) *Loader[InvolvedCompany] {
+		return c.InvolvedCompanies.Loader(ctx, SetFields("*"))
+	},
+	func(d *GameDetails, v *InvolvedCompany) { d.InvolvedCompanies = append(d.InvolvedCompanies, v) },
+)
+
+// GetDetails returns the GameDetails of a single Game identified by the
+// provided IGDB ID, with the provided Relations filled in. Provide the
+// SetFields functional option if you need to specify which fields of the
+// Game to retrieve; a Relation can only be filled in if the field
+// referencing it is retrieved.
+func (gs *GameService) GetDetails(ctx context.Context, id int, rels Relation, opts ...Option) (*GameDetails, error) {
+	g, err := gs.GetContext(ctx, id, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	det, err := gs.Hydrate(ctx, []*Game{g}, rels)
+	if err != nil {
+		return nil, err
+	}
+
+	return det[0], nil
+}
+
+// ListDetails returns the GameDetails of the Games identified by the provided
+// list of IGDB IDs, with the provided Relations filled in. The objects
+// referenced by every Game are retrieved together, so the number of API calls
+// depends on the number of Relations rather than the number of Games.
+func (gs *GameService) ListDetails(ctx context.Context, ids []int, rels Relation, opts ...Option) ([]*GameDetails, error) {
+	g, err := gs.ListContext(ctx, ids, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return gs.Hydrate(ctx, g, rels)
+}
+
+// Hydrate returns the GameDetails of the provided Games, retrieving the
+// objects of the provided Relations. Every Relation is retrieved concurrently
+// and the objects referenced by every Game are retrieved in as few API calls
+// as possible. Objects are listed in the order the Game references them and
+// references that do not match any object are ignored. A nil Game is given a
+// GameDetails without any Relation filled in.
+func (gs *GameService) Hydrate(ctx context.Context, games []*Game, rels Relation) ([]*GameDetails, error) {
+	det := make([]*GameDetails, len(games))
+	for i, g := range games {
+		det[i] = &GameDetails{Game: g}
+	}
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs error
+	)
+
+	run := func(fn func() error) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := fn(); err != nil {
+				mu.Lock()
+				if errs == nil {
+					errs = err
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for rel, r := range relations {
+		if rels&rel == 0 {
+			continue
+		}
+		r := r
+		run(func() error { return gs.hydrate(ctx, games, det, r) })
+	}
+
+	if rels&RelationCompanies != 0 {
+		run(func() error {
+			if err := gs.hydrate(ctx, games, det, companies); err != nil {
+				return err
+			}
+			return gs.hydrateCompanies(ctx, det)
+		})
+	}
+
+	wg.Wait()
+	if errs != nil {
+		return nil, errors.Wrap(errs, "cannot hydrate Games")
+	}
+
+	return det, nil
+}
+
+// hydrate retrieves the objects of the provided relation referenced by the
+// provided Games and assigns them to the corresponding GameDetails.
+func (gs *GameService) hydrate(ctx context.Context, games []*Game, det []*GameDetails, r relation) error {
+	var ids []int
+	seen := make(map[int]bool)
+	for _, g := range games {
+		if g == nil {
+			continue
+		}
+
+		for _, ref := range r.refs(g) {
+			if !seen[ref.ID] {
+				seen[ref.ID] = true
+				ids = append(ids, ref.ID)
+			}
+		}
+	}
+
+	if len(ids) < 1 {
+		return nil
+	}
+
+	assign, err := r.load(ctx, gs.client, ids)
+	if err != nil {
+		return err
+	}
+
+	for i, g := range games {
+		if g == nil {
+			continue
+		}
+
+		for _, ref := range r.refs(g) {
+			assign(det[i], ref.ID)
+		}
+	}
+
+	return nil
+}
+
+// hydrateCompanies retrieves the Companies referenced by the InvolvedCompanies
+// of the provided GameDetails and splits them by their involvement.
+func (gs *GameService) hydrateCompanies(ctx context.Context, det []*GameDetails) error {
+	var ids []int
+	seen := make(map[int]bool)
+	for _, d := range det {
+		for _, ic := range d.InvolvedCompanies {
+			if ic.Company.ID != 0 && !seen[ic.Company.ID] {
+				seen[ic.Company.ID] = true
+				ids = append(ids, ic.Company.ID)
+			}
+		}
+	}
+
+	if len(ids) < 1 {
+		return nil
+	}
+
+	found, err := gs.client.Companies.Loader(ctx, SetFields("*")).loadMap(ids)
+	if err != nil {
+		return err
+	}
+
+	for _, d := range det {
+		for _, ic := range d.InvolvedCompanies {
+			com, ok := found[ic.Company.ID]
+			if !ok {
+				continue
+			}
+
+			if ic.Developer {
+				d.Developers = append(d.Developers, com)
+			}
+			if ic.Publisher {
+				d.Publishers = append(d.Publishers, com)
+			}
+			if ic.Porting {
+				d.Porters = append(d.Porters, com)
+			}
+			if ic.Supporting {
+				d.Supporters = append(d.Supporters, com)
+			}
+		}
+	}
+
+	return nil
+}
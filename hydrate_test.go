@@ -0,0 +1,244 @@
+package igdb
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+// hydrateObjects are the objects served by startHydrateServer, keyed by
+// endpoint and ID.
+var hydrateObjects = map[string]map[int]string{
+	"games": {
+		1: `{"id": 1, "name": "first", "cover": 10, "genres": [20, 21], "involved_companies": [30, 31, 32], "franchise": 50, "franchises": [50, 51]}`,
+		2: `{"id": 2, "name": "second", "genres": [21, 22, 99], "involved_companies": [33]}`,
+	},
+	"covers":             {10: `{"id": 10, "image_id": "abc"}`},
+	"genres":             {20: `{"id": 20, "name": "Shooter"}`, 21: `{"id": 21, "name": "Puzzle"}`, 22: `{"id": 22, "name": "Racing"}`},
+	"involved_companies": {30: `{"id": 30, "company": 40, "developer": true}`, 31: `{"id": 31, "company": 41, "publisher": true, "porting": true}`, 32: `{"id": 32, "company": 42, "supporting": true}`, 33: `{"id": 33, "company": 40, "developer": true, "publisher": true}`},
+	"companies":          {40: `{"id": 40, "name": "Dev"}`, 41: `{"id": 41, "name": "Pub"}`, 42: `{"id": 42, "name": "Help"}`},
+	"franchises":         {50: `{"id": 50, "name": "Main"}`, 51: `{"id": 51, "name": "Other"}`},
+}
+
+// startHydrateServer initializes and returns a test server that responds to List
+// queries with the hydrateObjects whose IDs are requested. The requested IDs of
+// each request are recorded in the provided map by endpoint.
+func startHydrateServer(status int, calls map[string][][]int) (*httptest.Server, *Client) {
+	mu := sync.Mutex{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		end := strings.Trim(r.URL.Path, "/")
+
+		var ids []int
+		var objs []json.RawMessage
+		if m := idListRE.FindStringSubmatch(string(b)); m != nil {
+			for _, s := range strings.Split(m[1], ",") {
+				id, _ := strconv.Atoi(s)
+				ids = append(ids, id)
+			}
+		} else if i := strings.Index(string(b), "id = "); i >= 0 {
+			id, _ := strconv.Atoi(strings.TrimRight(string(b[i+5:]), "; "))
+			ids = append(ids, id)
+		}
+
+		mu.Lock()
+		calls[end] = append(calls[end], ids)
+		mu.Unlock()
+
+		if status != http.StatusOK && end != "games" {
+			w.WriteHeader(status)
+			return
+		}
+
+		for _, id := range ids {
+			if obj, ok := hydrateObjects[end][id]; ok {
+				objs = append(objs, json.RawMessage(obj))
+			}
+		}
+
+		if len(objs) == 0 {
+			w.Write([]byte("[]"))
+			return
+		}
+		json.NewEncoder(w).Encode(objs)
+	}))
+
+	return ts, NewClient(testKey, ts.Client(), WithBaseURL(ts.URL))
+}
+
+func TestGameService_ListDetails(t *testing.T) {
+	tests := []struct {
+		name      string
+		rels      Relation
+		status    int
+		wantCalls map[string][][]int
+		wantErr   error
+	}{
+		{"No relations", 0, http.StatusOK, map[string][][]int{"games": {{1, 2}}}, nil},
+		{"Genres", RelationGenres, http.StatusOK, map[string][][]int{"games": {{1, 2}}, "genres": {{20, 21, 22, 99}}}, nil},
+		{
+			"Companies",
+			RelationCompanies,
+			http.StatusOK,
+			map[string][][]int{"games": {{1, 2}}, "involved_companies": {{30, 31, 32, 33}}, "companies": {{40, 41, 42}}},
+			nil,
+		},
+		{
+			"Every relation",
+			RelationAll,
+			http.StatusOK,
+			map[string][][]int{
+				"games":              {{1, 2}},
+				"covers":             {{10}},
+				"genres":             {{20, 21, 22, 99}},
+				"involved_companies": {{30, 31, 32, 33}},
+				"companies":          {{40, 41, 42}},
+				"franchises":         {{50, 51}},
+			},
+			nil,
+		},
+		{"Failed relation", RelationGenres, http.StatusBadRequest, map[string][][]int{"games": {{1, 2}}, "genres": {{20, 21, 22, 99}}}, ErrBadRequest},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			calls := make(map[string][][]int)
+			ts, c := startHydrateServer(test.status, calls)
+			defer ts.Close()
+
+			det, err := c.Games.ListDetails(context.Background(), []int{1, 2}, test.rels)
+			if errors.Cause(err) != test.wantErr {
+				t.Fatalf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			for _, ids := range calls {
+				for _, id := range ids {
+					sort.Ints(id)
+				}
+			}
+			if !reflect.DeepEqual(calls, test.wantCalls) {
+				t.Errorf("got: <%v>, want: <%v>", calls, test.wantCalls)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if len(det) != 2 || det[0].Game.ID != 1 || det[1].Game.ID != 2 {
+				t.Fatalf("got: <%v>, want: GameDetails of Games 1 and 2", det)
+			}
+		})
+	}
+}
+
+func TestGameService_Hydrate(t *testing.T) {
+	calls := make(map[string][][]int)
+	ts, c := startHydrateServer(http.StatusOK, calls)
+	defer ts.Close()
+
+	g, err := c.Games.List([]int{1, 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	det, err := c.Games.Hydrate(context.Background(), g, RelationAll)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	names := func(v interface{}) []string {
+		var s []string
+		rv := reflect.ValueOf(v)
+		for i := 0; i < rv.Len(); i++ {
+			s = append(s, rv.Index(i).Elem().FieldByName("Name").String())
+		}
+		return s
+	}
+
+	tests := []struct {
+		name string
+		got  interface{}
+		want interface{}
+	}{
+		{"First cover", det[0].Cover.ImageID, "abc"},
+		{"Second cover", det[1].Cover, (*Cover)(nil)},
+		{"First genres", names(det[0].Genres), []string{"Shooter", "Puzzle"}},
+		{"Second genres", names(det[1].Genres), []string{"Puzzle", "Racing"}},
+		{"First franchises", names(det[0].Franchises), []string{"Main", "Other"}},
+		{"First involved companies", len(det[0].InvolvedCompanies), 3},
+		{"First developers", names(det[0].Developers), []string{"Dev"}},
+		{"First publishers", names(det[0].Publishers), []string{"Pub"}},
+		{"First porters", names(det[0].Porters), []string{"Pub"}},
+		{"First supporters", names(det[0].Supporters), []string{"Help"}},
+		{"Second developers", names(det[1].Developers), []string{"Dev"}},
+		{"Second publishers", names(det[1].Publishers), []string{"Dev"}},
+		{"Second porters", names(det[1].Porters), []string(nil)},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if !reflect.DeepEqual(test.got, test.want) {
+				t.Errorf("got: <%v>, want: <%v>", test.got, test.want)
+			}
+		})
+	}
+}
+
+func TestGameService_HydrateNil(t *testing.T) {
+	calls := make(map[string][][]int)
+	ts, c := startHydrateServer(http.StatusOK, calls)
+	defer ts.Close()
+
+	g, err := c.Games.List([]int{1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	det, err := c.Games.Hydrate(context.Background(), []*Game{nil, g[0]}, RelationCover|RelationCompanies)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(det) != 2 {
+		t.Fatalf("got: <%v> GameDetails, want: <%v>", len(det), 2)
+	}
+
+	if !reflect.DeepEqual(det[0], &GameDetails{}) {
+		t.Errorf("got: <%v>, want: <%v>", det[0], &GameDetails{})
+	}
+
+	if det[1].Cover == nil || det[1].Cover.ImageID != "abc" {
+		t.Errorf("got: <%v>, want cover with image ID <%v>", det[1].Cover, "abc")
+	}
+}
+
+func TestGameService_GetDetails(t *testing.T) {
+	calls := make(map[string][][]int)
+	ts, c := startHydrateServer(http.StatusOK, calls)
+	defer ts.Close()
+
+	det, err := c.Games.GetDetails(context.Background(), 1, RelationCover|RelationGenres)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if det.Game.Name != "first" {
+		t.Errorf("got: <%v>, want: <%v>", det.Game.Name, "first")
+	}
+
+	if det.Cover == nil || len(det.Genres) != 2 {
+		t.Errorf("got: <%v, %v>, want: a Cover and 2 Genres", det.Cover, det.Genres)
+	}
+
+	if det.Platforms != nil || det.Developers != nil {
+		t.Errorf("got: <%v, %v>, want: no unrequested relations", det.Platforms, det.Developers)
+	}
+}
@@ -1,32 +1,40 @@
 package igdb
 
 import (
+	"context"
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
+	"strings"
 
-	"github.com/Henry-Sarabia/apicalypse"
+	"github.com/Henry-Sarabia/blank"
 	"github.com/pkg/errors"
 )
 
-// igdbURL is the base URL for the IGDB API.
+// igdbURL is the base URL for the legacy v3 IGDB API.
 const igdbURL string = "https://api-v3.igdb.com/"
 
-// service is the underlying struct that handles
-// all API calls for different IGDB endpoints.
-type service struct {
-	client *Client
-	end    endpoint
-}
+// igdbV4URL is the base URL for the v4 IGDB API.
+const igdbV4URL string = "https://api.igdb.com/v4/"
+
+//go:generate go run ./internal/apigen
 
 // Client wraps an HTTP Client used to communicate with the IGDB,
-// the root URL of the IGDB, and the user's IGDB API key.
-// Client also initializes all the separate services to communicate
-// with each individual IGDB API endpoint.
+// the root URL of the IGDB, and the Authenticator used to authorize
+// each request. Client also initializes all the separate services to
+// communicate with each individual IGDB API endpoint.
 type Client struct {
 	http      *http.Client
 	rootURL   string
-	key       string
+	baseURL   string
+	v4        bool
+	method    string
+	auth      Authenticator
+	limiter   *limiter
+	retry     *RetryPolicy
+	cache     Cache
+	cacheTTL  CacheTTL
+	flights   *flightGroup
 	maxLimit  int
 	maxOffset int
 
@@ -98,143 +106,360 @@ type Client struct {
 	TestDummies    *TestDummyService
 }
 
+// ClientOption functions are used to configure a Client. ClientOption is
+// the first-order function returned by the available client options (e.g.
+// WithAuthenticator) which is then passed into NewClient or NewTwitchClient.
+type ClientOption func(*Client)
+
+// WithAuthenticator is a client option used to replace the Authenticator
+// that authorizes each request sent to the IGDB.
+func WithAuthenticator(auth Authenticator) ClientOption {
+	return func(c *Client) {
+		c.auth = auth
+	}
+}
+
+// WithBaseURL is a client option used to replace the base URL every endpoint
+// is resolved against (e.g. "https://api.igdb.com/v4/"). This is primarily
+// used to communicate with a proxy or a test server.
+func WithBaseURL(url string) ClientOption {
+	return func(c *Client) {
+		if blank.Is(url) {
+			return
+		}
+
+		if !strings.HasSuffix(url, "/") {
+			url += "/"
+		}
+
+		c.baseURL = url
+	}
+}
+
+// WithV4 is a client option used to communicate with the v4 IGDB API. Queries
+// are sent as the plain text body of a POST request to the v4 base URL instead
+// of the body of a GET request. A base URL provided with WithBaseURL is used
+// instead of the v4 base URL, whatever the order of the options.
+//
+// For more information visit: https://api-docs.igdb.com/#requests
+func WithV4() ClientOption {
+	return func(c *Client) {
+		c.v4 = true
+	}
+}
+
 // NewClient returns a new Client configured to communicate with the IGDB.
 // The provided apiKey will be used to make requests on your behalf. The
 // provided HTTP Client will be the client making requests to the IGDB. If no
-// HTTP Client is provided, a default HTTP client is used instead.
+// HTTP Client is provided, a default HTTP client is used instead. Any
+// provided client options are applied in order.
 //
 // If you need an IGDB API key, please visit: https://api.igdb.com/signup
-func NewClient(apiKey string, custom *http.Client) *Client {
+func NewClient(apiKey string, custom *http.Client, opts ...ClientOption) *Client {
 	if custom == nil {
 		custom = http.DefaultClient
 	}
 
 	c := &Client{
-		http:    custom,
-		rootURL: igdbURL,
-		key:     apiKey,
-	}
-
-	c.Achievements = &AchievementService{client: c, end: EndpointAchievement}
-	c.AchievementIcons = &AchievementIconService{client: c, end: EndpointAchievementIcon}
-	c.AgeRatings = &AgeRatingService{client: c, end: EndpointAgeRating}
-	c.AgeRatingContents = &AgeRatingContentService{client: c, end: EndpointAgeRatingContent}
-	c.AlternativeNames = &AlternativeNameService{client: c, end: EndpointAlternativeName}
-	c.Artworks = &ArtworkService{client: c, end: EndpointArtwork}
-	c.Characters = &CharacterService{client: c, end: EndpointCharacter}
-	c.CharacterMugshots = &CharacterMugshotService{client: c, end: EndpointCharacterMugshot}
-	c.Collections = &CollectionService{client: c, end: EndpointCollection}
-	c.Companies = &CompanyService{client: c, end: EndpointCompany}
-	c.CompanyLogos = &CompanyLogoService{client: c, end: EndpointCompanyLogo}
-	c.CompanyWebsites = &CompanyWebsiteService{client: c, end: EndpointCompanyWebsite}
-	c.Covers = &CoverService{client: c, end: EndpointCover}
-	c.ExternalGames = &ExternalGameService{client: c, end: EndpointExternalGame}
-	c.Feeds = &FeedService{client: c, end: EndpointFeed}
-	c.Franchises = &FranchiseService{client: c, end: EndpointFranchise}
-	c.Games = &GameService{client: c, end: EndpointGame}
-	c.GameEngines = &GameEngineService{client: c, end: EndpointGameEngine}
-	c.GameEngineLogos = &GameEngineLogoService{client: c, end: EndpointGameEngineLogo}
-	c.GameModes = &GameModeService{client: c, end: EndpointGameMode}
-	c.GameVersions = &GameVersionService{client: c, end: EndpointGameVersion}
-	c.GameVersionFeatures = &GameVersionFeatureService{client: c, end: EndpointGameVersionFeature}
-	c.GameVersionFeatureValues = &GameVersionFeatureValueService{client: c, end: EndpointGameVersionFeatureValue}
-	c.GameVideos = &GameVideoService{client: c, end: EndpointGameVideo}
-	c.Genres = &GenreService{client: c, end: EndpointGenre}
-	c.InvolvedCompanies = &InvolvedCompanyService{client: c, end: EndpointInvolvedCompany}
-	c.Keywords = &KeywordService{client: c, end: EndpointKeyword}
-	c.MultiplayerModes = &MultiplayerModeService{client: c, end: EndpointMultiplayerMode}
-	c.Pages = &PageService{client: c, end: EndpointPage}
-	c.PageBackgrounds = &PageBackgroundService{client: c, end: EndpointPageBackground}
-	c.PageLogos = &PageLogoService{client: c, end: EndpointPageLogo}
-	c.PageWebsites = &PageWebsiteService{client: c, end: EndpointPageWebsite}
-	c.Platforms = &PlatformService{client: c, end: EndpointPlatform}
-	c.PlatformLogos = &PlatformLogoService{client: c, end: EndpointPlatformLogo}
-	c.PlatformVersions = &PlatformVersionService{client: c, end: EndpointPlatformVersion}
-	c.PlatformVersionCompanies = &PlatformVersionCompanyService{client: c, end: EndpointPlatformVersionCompany}
-	c.PlatformVersionReleaseDates = &PlatformVersionReleaseDateService{client: c, end: EndpointPlatformVersionReleaseDate}
-	c.PlatformWebsites = &PlatformWebsiteService{client: c, end: EndpointPlatformWebsite}
-	c.PlayerPerspectives = &PlayerPerspectiveService{client: c, end: EndpointPlayerPerspective}
-	c.ProductFamilies = &ProductFamilyService{client: c, end: EndpointProductFamily}
-	c.Pulses = &PulseService{client: c, end: EndpointPulse}
-	c.PulseGroups = &PulseGroupService{client: c, end: EndpointPulseGroup}
-	c.PulseSources = &PulseSourceService{client: c, end: EndpointPulseSource}
-	c.PulseURLs = &PulseURLService{client: c, end: EndpointPulseURL}
-	c.ReleaseDates = &ReleaseDateService{client: c, end: EndpointReleaseDate}
-	c.Screenshots = &ScreenshotService{client: c, end: EndpointScreenshot}
-	c.Themes = &ThemeService{client: c, end: EndpointTheme}
-	c.TimeToBeats = &TimeToBeatService{client: c, end: EndpointTimeToBeat}
-	c.Titles = &TitleService{client: c, end: EndpointTitle}
-	c.Websites = &WebsiteService{client: c, end: EndpointWebsite}
-
-	c.Credits = &CreditService{client: c, end: EndpointCredit}
-	c.FeedFollows = &FeedFollowService{client: c, end: EndpointFeedFollow}
-	c.Follows = &FollowService{client: c, end: EndpointFollow}
-	c.Lists = &ListService{client: c, end: EndpointList}
-	c.ListEntrys = &ListEntryService{client: c, end: EndpointListEntry}
-	c.Persons = &PersonService{client: c, end: EndpointPerson}
-	c.PersonMugshots = &PersonMugshotService{client: c, end: EndpointPersonMugshot}
-	c.PersonWebsites = &PersonWebsiteService{client: c, end: EndpointPersonWebsite}
-	c.Rates = &RateService{client: c, end: EndpointRate}
-	c.Reviews = &ReviewService{client: c, end: EndpointReview}
-	c.ReviewVideos = &ReviewVideoService{client: c, end: EndpointReviewVideo}
-	c.SocialMetrics = &SocialMetricService{client: c, end: EndpointSocialMetric}
-	c.TestDummies = &TestDummyService{client: c, end: EndpointTestDummy}
+		http: custom,
+		auth: keyAuth(apiKey),
+	}
+
+	c.Achievements = &AchievementService{newService[Achievement](c, EndpointAchievement, "Achievements")}
+	c.AchievementIcons = &AchievementIconService{newService[AchievementIcon](c, EndpointAchievementIcon, "AchievementIcons")}
+	c.AgeRatings = &AgeRatingService{newService[AgeRating](c, EndpointAgeRating, "AgeRatings")}
+	c.AgeRatingContents = &AgeRatingContentService{newService[AgeRatingContent](c, EndpointAgeRatingContent, "AgeRatingContents")}
+	c.AlternativeNames = &AlternativeNameService{newService[AlternativeName](c, EndpointAlternativeName, "AlternativeNames")}
+	c.Artworks = &ArtworkService{newService[Artwork](c, EndpointArtwork, "Artworks")}
+	c.Characters = &CharacterService{newSearchableService[Character](c, EndpointCharacter, "Characters")}
+	c.CharacterMugshots = &CharacterMugshotService{newService[CharacterMugshot](c, EndpointCharacterMugshot, "CharacterMugshots")}
+	c.Collections = &CollectionService{newSearchableService[Collection](c, EndpointCollection, "Collections")}
+	c.Companies = &CompanyService{newService[Company](c, EndpointCompany, "Companies")}
+	c.CompanyLogos = &CompanyLogoService{newService[CompanyLogo](c, EndpointCompanyLogo, "CompanyLogos")}
+	c.CompanyWebsites = &CompanyWebsiteService{newService[CompanyWebsite](c, EndpointCompanyWebsite, "CompanyWebsites")}
+	c.Covers = &CoverService{newService[Cover](c, EndpointCover, "Covers")}
+	c.ExternalGames = &ExternalGameService{newService[ExternalGame](c, EndpointExternalGame, "ExternalGames")}
+	c.Feeds = &FeedService{newService[Feed](c, EndpointFeed, "Feeds")}
+	c.Franchises = &FranchiseService{newService[Franchise](c, EndpointFranchise, "Franchises")}
+	c.Games = &GameService{newSearchableService[Game](c, EndpointGame, "Games")}
+	c.GameEngines = &GameEngineService{newService[GameEngine](c, EndpointGameEngine, "GameEngines")}
+	c.GameEngineLogos = &GameEngineLogoService{newService[GameEngineLogo](c, EndpointGameEngineLogo, "GameEngineLogos")}
+	c.GameModes = &GameModeService{newService[GameMode](c, EndpointGameMode, "GameModes")}
+	c.GameVersions = &GameVersionService{newService[GameVersion](c, EndpointGameVersion, "GameVersions")}
+	c.GameVersionFeatures = &GameVersionFeatureService{newService[GameVersionFeature](c, EndpointGameVersionFeature, "GameVersionFeatures")}
+	c.GameVersionFeatureValues = &GameVersionFeatureValueService{newService[GameVersionFeatureValue](c, EndpointGameVersionFeatureValue, "GameVersionFeatureValues")}
+	c.GameVideos = &GameVideoService{newService[GameVideo](c, EndpointGameVideo, "GameVideos")}
+	c.Genres = &GenreService{newService[Genre](c, EndpointGenre, "Genres")}
+	c.InvolvedCompanies = &InvolvedCompanyService{newService[InvolvedCompany](c, EndpointInvolvedCompany, "InvolvedCompanies")}
+	c.Keywords = &KeywordService{newService[Keyword](c, EndpointKeyword, "Keywords")}
+	c.MultiplayerModes = &MultiplayerModeService{newService[MultiplayerMode](c, EndpointMultiplayerMode, "MultiplayerModes")}
+	c.Pages = &PageService{newService[Page](c, EndpointPage, "Pages")}
+	c.PageBackgrounds = &PageBackgroundService{newService[PageBackground](c, EndpointPageBackground, "PageBackgrounds")}
+	c.PageLogos = &PageLogoService{newService[PageLogo](c, EndpointPageLogo, "PageLogos")}
+	c.PageWebsites = &PageWebsiteService{newService[PageWebsite](c, EndpointPageWebsite, "PageWebsites")}
+	c.Platforms = &PlatformService{newSearchableService[Platform](c, EndpointPlatform, "Platforms")}
+	c.PlatformLogos = &PlatformLogoService{newService[PlatformLogo](c, EndpointPlatformLogo, "PlatformLogos")}
+	c.PlatformVersions = &PlatformVersionService{newService[PlatformVersion](c, EndpointPlatformVersion, "PlatformVersions")}
+	c.PlatformVersionCompanies = &PlatformVersionCompanyService{newService[PlatformVersionCompany](c, EndpointPlatformVersionCompany, "PlatformVersionCompanies")}
+	c.PlatformVersionReleaseDates = &PlatformVersionReleaseDateService{newService[PlatformVersionReleaseDate](c, EndpointPlatformVersionReleaseDate, "PlatformVersionReleaseDates")}
+	c.PlatformWebsites = &PlatformWebsiteService{newService[PlatformWebsite](c, EndpointPlatformWebsite, "PlatformWebsites")}
+	c.PlayerPerspectives = &PlayerPerspectiveService{newService[PlayerPerspective](c, EndpointPlayerPerspective, "PlayerPerspectives")}
+	c.ProductFamilies = &ProductFamilyService{newService[ProductFamily](c, EndpointProductFamily, "ProductFamilies")}
+	c.Pulses = &PulseService{newService[Pulse](c, EndpointPulse, "Pulses")}
+	c.PulseGroups = &PulseGroupService{newService[PulseGroup](c, EndpointPulseGroup, "PulseGroups")}
+	c.PulseSources = &PulseSourceService{newService[PulseSource](c, EndpointPulseSource, "PulseSources")}
+	c.PulseURLs = &PulseURLService{newSearchableService[PulseURL](c, EndpointPulseURL, "PulseURLs")}
+	c.ReleaseDates = &ReleaseDateService{newService[ReleaseDate](c, EndpointReleaseDate, "ReleaseDates")}
+	c.Screenshots = &ScreenshotService{newService[Screenshot](c, EndpointScreenshot, "Screenshots")}
+	c.Themes = &ThemeService{newSearchableService[Theme](c, EndpointTheme, "Themes")}
+	c.TimeToBeats = &TimeToBeatService{newService[TimeToBeat](c, EndpointTimeToBeat, "TimeToBeats")}
+	c.Titles = &TitleService{newService[Title](c, EndpointTitle, "Titles")}
+	c.Websites = &WebsiteService{newService[Website](c, EndpointWebsite, "Websites")}
+
+	c.Credits = &CreditService{newService[Credit](c, EndpointCredit, "Credits")}
+	c.FeedFollows = &FeedFollowService{newService[FeedFollow](c, EndpointFeedFollow, "FeedFollows")}
+	c.Follows = &FollowService{newService[Follow](c, EndpointFollow, "Follows")}
+	c.Lists = &ListService{newService[List](c, EndpointList, "Lists")}
+	c.ListEntrys = &ListEntryService{newService[ListEntry](c, EndpointListEntry, "ListEntries")}
+	c.Persons = &PersonService{newSearchableService[Person](c, EndpointPerson, "People")}
+	c.PersonMugshots = &PersonMugshotService{newService[PersonMugshot](c, EndpointPersonMugshot, "PersonMugshots")}
+	c.PersonWebsites = &PersonWebsiteService{newService[PersonWebsite](c, EndpointPersonWebsite, "PersonWebsites")}
+	c.Rates = &RateService{newService[Rate](c, EndpointRate, "Rates")}
+	c.Reviews = &ReviewService{newService[Review](c, EndpointReview, "Reviews")}
+	c.ReviewVideos = &ReviewVideoService{newService[ReviewVideo](c, EndpointReviewVideo, "ReviewVideos")}
+	c.SocialMetrics = &SocialMetricService{newService[SocialMetric](c, EndpointSocialMetric, "SocialMetrics")}
+	c.TestDummies = &TestDummyService{newService[TestDummy](c, EndpointTestDummy, "TestDummies")}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	c.rootURL, c.method = igdbURL, "GET"
+	if c.v4 {
+		c.rootURL, c.method = igdbV4URL, "POST"
+	}
+	if c.baseURL != "" {
+		c.rootURL = c.baseURL
+	}
+
 	return c
 }
 
+// NewTwitchClient returns a new Client configured to communicate with the v4 IGDB
+// API using the Twitch OAuth client credentials flow. The provided Twitch Client-ID
+// and client secret are used to retrieve app access tokens on your behalf. The
+// provided HTTP Client will be the client making requests to both Twitch and
+// the IGDB. If no HTTP Client is provided, a default HTTP client is used instead.
+//
+// If you need a Twitch Client-ID and secret, please visit: https://dev.twitch.tv/console/apps
+func NewTwitchClient(clientID, clientSecret string, custom *http.Client, opts ...ClientOption) *Client {
+	auth := NewTwitchAuth(clientID, clientSecret, custom)
+	opts = append([]ClientOption{WithV4(), WithAuthenticator(auth)}, opts...)
+
+	return NewClient("", custom, opts...)
+}
+
 // Request configures a new request for the provided URL and
 // adds the necessary headers to communicate with the IGDB.
-func (c *Client) request(end endpoint, opts ...Option) (*http.Request, error) {
-	unwrapped, err := unwrapOptions(opts...)
+// The provided context is attached to the request so that
+// cancellation and deadlines reach the HTTP client.
+func (c *Client) request(ctx context.Context, end endpoint, opts ...Option) (*http.Request, error) {
+	clauses, err := applyOptions(opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot create request with invalid options")
 	}
 
-	req, err := apicalypse.NewRequest("GET", c.rootURL+string(end), unwrapped...)
+	ctx = withCacheMode(ctx, cacheMode(clauses[metaCache]))
+
+	return c.requestBody(ctx, end, renderClauses(clauses))
+}
+
+// requestBody configures a new request for the provided URL with the provided
+// rendered query as its body and adds the necessary headers to communicate
+// with the IGDB.
+func (c *Client) requestBody(ctx context.Context, end endpoint, qry string) (*http.Request, error) {
+	req, err := http.NewRequest(c.method, c.rootURL+string(end), strings.NewReader(qry))
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot make request for '%s' endpoint", end)
 	}
 
-	req.Header.Add("user-key", c.key)
+	req = req.WithContext(ctx)
 	req.Header.Add("Accept", "application/json")
+	if c.method == "POST" {
+		req.Header.Add("Content-Type", "text/plain")
+	}
 
 	return req, nil
 }
 
 // Send sends the provided request and stores the response in the value pointed to by result.
-// The response will be checked and return any errors.
+// The response will be checked and return any errors. The request is bound to the
+// context it was created with.
 func (c *Client) send(req *http.Request, result interface{}) error {
-	resp, err := c.http.Do(req)
+	b, err := c.coalescedFetch(req)
 	if err != nil {
-		return errors.Wrap(err, "http client cannot send request")
+		return err
+	}
+
+	if isBracketPair(b) {
+		return c.annotate(req, &APIError{StatusCode: http.StatusOK, Body: b, Err: ErrNotFound})
+	}
+
+	err = json.Unmarshal(b, &result)
+	if err != nil {
+		return errors.Wrap(ErrInvalidJSON, err.Error())
+	}
+
+	return nil
+}
+
+// fetch sends the provided request and returns the body of a successful response.
+// Requests that fail with a transient error are retried according to the Client's
+// retry policy, if any.
+func (c *Client) fetch(req *http.Request) ([]byte, error) {
+	for attempt := 1; ; attempt++ {
+		b, resp, err := c.roundTrip(req)
+		if !c.retry.shouldRetry(attempt, resp, err) {
+			return b, err
+		}
+
+		if err := sleep(req.Context(), c.retry.backoff(attempt, resp)); err != nil {
+			return nil, errors.Wrap(err, "cannot wait to retry request")
+		}
+
+		if req, err = rewind(req); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// roundTrip sends the provided request once, checks the response for errors,
+// and returns the response body. The response is returned alongside any error
+// so that its status and headers can be inspected; its body is always closed.
+func (c *Client) roundTrip(req *http.Request) ([]byte, *http.Response, error) {
+	if c.limiter != nil {
+		release, err := c.limiter.enter(req.Context())
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "cannot wait for an open request slot")
+		}
+		defer release()
+	}
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, nil, err
 	}
 	defer resp.Body.Close()
 
 	if err = checkResponse(resp); err != nil {
-		return err
+		return nil, resp, c.annotate(req, err)
 	}
 
 	b, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return errors.Wrap(err, "cannot read response body")
+		return nil, resp, errors.Wrap(err, "cannot read response body")
 	}
 
-	if isBracketPair(b) {
-		return ErrNoResults
+	return b, resp, nil
+}
+
+// Do authenticates and sends the provided request. If the IGDB responds with an
+// unauthorized status and the Authenticator caches its credentials, the
+// credentials are invalidated and the request is retried once.
+func (c *Client) do(req *http.Request) (*http.Response, error) {
+	resp, err := c.authorizedDo(req)
+	if err != nil {
+		return nil, err
 	}
 
-	err = json.Unmarshal(b, &result)
+	inv, ok := c.auth.(invalidator)
+	if !ok || resp.StatusCode != http.StatusUnauthorized {
+		return resp, nil
+	}
+
+	retry, err := rewind(req)
 	if err != nil {
-		return errors.Wrap(errInvalidJSON, err.Error())
+		return resp, nil
 	}
 
-	return nil
+	resp.Body.Close()
+	inv.Invalidate()
+
+	return c.authorizedDo(retry)
+}
+
+// authorizedDo adds the Client's credentials to the provided request and sends it
+// once the Client's rate limiter, if any, allows it.
+func (c *Client) authorizedDo(req *http.Request) (*http.Response, error) {
+	if c.limiter != nil {
+		if err := c.limiter.wait(req.Context()); err != nil {
+			return nil, errors.Wrap(err, "cannot wait for rate limit")
+		}
+	}
+
+	if c.auth != nil {
+		if err := c.auth.Authenticate(req.Context(), req); err != nil {
+			return nil, errors.Wrap(err, "cannot authenticate request")
+		}
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "http client cannot send request")
+	}
+
+	if c.limiter != nil {
+		c.limiter.observe(resp)
+	}
+
+	return resp, nil
+}
+
+// annotate adds the endpoint and query of the provided request to the
+// provided error if it is an *APIError.
+func (c *Client) annotate(req *http.Request, err error) error {
+	e, ok := err.(*APIError)
+	if !ok {
+		return err
+	}
+
+	e.Endpoint = strings.TrimPrefix(req.URL.String(), c.rootURL)
+
+	if req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			b, _ := ioutil.ReadAll(body)
+			e.Query = string(b)
+		}
+	}
+
+	return e
+}
+
+// rewind returns a copy of the provided request with a fresh body so that
+// the request can be sent again.
+func rewind(req *http.Request) (*http.Request, error) {
+	r := req.Clone(req.Context())
+	if req.Body == nil || req.Body == http.NoBody {
+		return r, nil
+	}
+
+	if req.GetBody == nil {
+		return nil, errors.New("request body cannot be rewound")
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot rewind request body")
+	}
+	r.Body = body
+
+	return r, nil
 }
 
 // Get sends a GET request to the provided endpoint with the provided options and
 // stores the results in the value pointed to by result.
-func (c *Client) get(end endpoint, result interface{}, opts ...Option) error {
-	req, err := c.request(end, opts...)
+func (c *Client) get(ctx context.Context, end endpoint, result interface{}, opts ...Option) error {
+	req, err := c.request(ctx, end, opts...)
 	if err != nil {
 		return err
 	}
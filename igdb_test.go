@@ -1,12 +1,15 @@
 package igdb
 
 import (
+	"context"
 	"github.com/pkg/errors"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestClient_Request(t *testing.T) {
@@ -25,7 +28,7 @@ func TestClient_Request(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			c := NewClient("somekey", nil)
 
-			req, err := c.request(test.end, test.opts...)
+			req, err := c.request(context.Background(), test.end, test.opts...)
 			if errors.Cause(err) != test.wantErr {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
@@ -49,6 +52,52 @@ func TestClient_Request(t *testing.T) {
 	}
 }
 
+func TestClient_RequestV4(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    []ClientOption
+		end     endpoint
+		wantURL string
+	}{
+		{"V4 base URL", []ClientOption{WithV4()}, testEndpoint, igdbV4URL + testEndpoint},
+		{"Custom base URL", []ClientOption{WithV4(), WithBaseURL("http://localhost:8080/igdb")}, testEndpoint, "http://localhost:8080/igdb/" + testEndpoint},
+		{"Custom base URL before V4", []ClientOption{WithBaseURL("http://localhost:8080/igdb"), WithV4()}, testEndpoint, "http://localhost:8080/igdb/" + testEndpoint},
+		{"Count path", []ClientOption{WithV4()}, EndpointGame + "count", igdbV4URL + "games/count"},
+		{"Meta path", []ClientOption{WithV4()}, EndpointGame + "meta", igdbV4URL + "games/meta"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := NewClient("somekey", nil, test.opts...)
+
+			req, err := c.request(context.Background(), test.end, SetFields("name"), SetLimit(5))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if req.Method != "POST" {
+				t.Errorf("got: <%v>, want: <%v>", req.Method, "POST")
+			}
+
+			if req.URL.String() != test.wantURL {
+				t.Errorf("got: <%v>, want: <%v>", req.URL.String(), test.wantURL)
+			}
+
+			if ct := req.Header.Get("Content-Type"); ct != "text/plain" {
+				t.Errorf("got: <%v>, want: <%v>", ct, "text/plain")
+			}
+
+			b, err := ioutil.ReadAll(req.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if string(b) != "fields name; limit 5; " {
+				t.Errorf("got: <%v>, want: <%v>", string(b), "fields name; limit 5; ")
+			}
+		})
+	}
+}
+
 func TestClient_Send(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -59,7 +108,7 @@ func TestClient_Send(t *testing.T) {
 	}{
 		{"Status OK, populated response", http.StatusOK, testResult, testResultPlaceholder{SomeField: "some_value"}, nil},
 		{"Status OK, empty array response", http.StatusOK, "[]", testResultPlaceholder{}, ErrNoResults},
-		{"Status OK, empty response", http.StatusOK, "", testResultPlaceholder{}, errInvalidJSON},
+		{"Status OK, empty response", http.StatusOK, "", testResultPlaceholder{}, ErrInvalidJSON},
 		{"Status BadRequest, populated response", http.StatusBadRequest, testResult, testResultPlaceholder{}, ErrBadRequest},
 		{"Status BadRequest, empty array response", http.StatusBadRequest, "[]", testResultPlaceholder{}, ErrBadRequest},
 		{"Status BadRequest, empty response", http.StatusBadRequest, "", testResultPlaceholder{}, ErrBadRequest},
@@ -176,7 +225,7 @@ func TestClient_Get(t *testing.T) {
 			"",
 			[]Option{},
 			testResultPlaceholder{},
-			errInvalidJSON,
+			ErrInvalidJSON,
 		},
 		{
 			"Status OK, empty response, single valid option",
@@ -184,7 +233,7 @@ func TestClient_Get(t *testing.T) {
 			"",
 			[]Option{SetLimit(15)},
 			testResultPlaceholder{},
-			errInvalidJSON,
+			ErrInvalidJSON,
 		},
 		{
 			"Status OK, empty response, multiple valid options",
@@ -192,7 +241,7 @@ func TestClient_Get(t *testing.T) {
 			"",
 			[]Option{SetLimit(15), SetOffset(20)},
 			testResultPlaceholder{},
-			errInvalidJSON,
+			ErrInvalidJSON,
 		},
 		{
 			"Status OK, empty response, single invalid option",
@@ -594,7 +643,7 @@ func TestClient_Get(t *testing.T) {
 
 			res := testResultPlaceholder{}
 
-			err := c.get(testEndpoint, &res, test.opts...)
+			err := c.get(context.Background(), testEndpoint, &res, test.opts...)
 			if errors.Cause(err) != test.wantErr {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
@@ -605,3 +654,54 @@ func TestClient_Get(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_GetContext(t *testing.T) {
+	tests := []struct {
+		name    string
+		ctx     func() (context.Context, context.CancelFunc)
+		wantErr error
+	}{
+		{
+			"Canceled context",
+			func() (context.Context, context.CancelFunc) {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+				return ctx, cancel
+			},
+			context.Canceled,
+		},
+		{
+			"Expired deadline",
+			func() (context.Context, context.CancelFunc) {
+				return context.WithTimeout(context.Background(), time.Millisecond)
+			},
+			context.DeadlineExceeded,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			done := make(chan struct{})
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				select {
+				case <-r.Context().Done():
+				case <-done:
+				}
+			}))
+			defer ts.Close()
+			defer close(done)
+
+			c := NewClient(testKey, ts.Client())
+			c.rootURL = ts.URL + "/"
+
+			ctx, cancel := test.ctx()
+			defer cancel()
+
+			res := testResultPlaceholder{}
+
+			err := c.get(ctx, testEndpoint, &res)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", err, test.wantErr)
+			}
+		})
+	}
+}
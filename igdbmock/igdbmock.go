@@ -0,0 +1,146 @@
+// Package igdbmock provides mocks of the interfaces of the igdb package, such
+// as igdb.API and igdb.GameAPI, for testing code that depends on them without
+// an HTTP server. Every mock records the calls made to it and returns the
+// responses scripted with its Func fields.
+//
+// The interfaces, and so the mocks, only cover the basic operations of the
+// services, those that make a single request. Iterate, IterateSearch, Scan,
+// Loader, GetDetails, and Calendar return values that only a real service can
+// build, so code using them depends on the services themselves.
+//
+// The mocks are generated by apigen along with the interfaces they implement.
+package igdbmock
+
+import (
+	"context"
+	"sync"
+
+	"github.com/Henry-Sarabia/igdb"
+	"github.com/pkg/errors"
+)
+
+// ErrNotScripted occurs when a method of a mock is called
+// without a Func scripting its response.
+var ErrNotScripted = errors.New("mock method called without a scripted response")
+
+// notScripted returns an error wrapping ErrNotScripted for
+// the provided method of the provided mock.
+func notScripted(mock, method string) error {
+	return errors.Wrapf(ErrNotScripted, "igdbmock: %s.%s", mock, method)
+}
+
+// Call is a call made to a mock.
+type Call struct {
+	// Mock is the name of the mock (e.g. "GameAPI" or "Client").
+	Mock string
+	// Method is the name of the method (e.g. "GetContext").
+	Method string
+	// Args are the arguments of the call other than the context and
+	// the functional options (e.g. the ID provided to Get).
+	Args []interface{}
+	// Opts are the functional options of the call.
+	Opts []igdb.Option
+	// Query is the Apicalypse query rendered from the functional options
+	// (e.g. "fields name; limit 5; "), or empty if they are invalid.
+	Query string
+}
+
+// callLog records the calls made to a mock and to its parent, if any.
+type callLog struct {
+	mu     sync.Mutex
+	parent *callLog
+	calls  []Call
+}
+
+// record records a call to the provided method of the provided mock.
+func (l *callLog) record(mock, method string, opts []igdb.Option, args ...interface{}) {
+	qry, _ := igdb.RenderQuery(opts...)
+	l.add(Call{Mock: mock, Method: method, Args: args, Opts: opts, Query: qry})
+}
+
+// add adds the provided call to the log and to its parent.
+func (l *callLog) add(c Call) {
+	l.mu.Lock()
+	l.calls = append(l.calls, c)
+	l.mu.Unlock()
+
+	if l.parent != nil {
+		l.parent.add(c)
+	}
+}
+
+// get returns the recorded calls.
+func (l *callLog) get() []Call {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return append([]Call(nil), l.calls...)
+}
+
+// reset forgets the recorded calls.
+func (l *callLog) reset() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.calls = nil
+}
+
+// Calls returns the calls made to the Client and to every one
+// of its services, in order.
+func (c *Client) Calls() []Call {
+	return c.calls.get()
+}
+
+// Reset forgets the calls made to the Client. The calls
+// recorded by each of its services are kept.
+func (c *Client) Reset() {
+	c.calls.reset()
+}
+
+// Search records the call and returns the response of SearchFunc.
+func (c *Client) Search(qry string, opts ...igdb.Option) ([]*igdb.SearchResult, error) {
+	return c.search(context.Background(), "Search", qry, opts...)
+}
+
+// SearchContext records the call and returns the response of SearchFunc.
+func (c *Client) SearchContext(ctx context.Context, qry string, opts ...igdb.Option) ([]*igdb.SearchResult, error) {
+	return c.search(ctx, "SearchContext", qry, opts...)
+}
+
+func (c *Client) search(ctx context.Context, method string, qry string, opts ...igdb.Option) ([]*igdb.SearchResult, error) {
+	c.calls.record("Client", method, opts, qry)
+	if c.SearchFunc == nil {
+		return nil, notScripted("Client", method)
+	}
+
+	return c.SearchFunc(ctx, qry, opts...)
+}
+
+// Status records the call and returns the response of StatusFunc.
+func (c *Client) Status() (*igdb.Status, error) {
+	return c.status(context.Background(), "Status")
+}
+
+// StatusContext records the call and returns the response of StatusFunc.
+func (c *Client) StatusContext(ctx context.Context) (*igdb.Status, error) {
+	return c.status(ctx, "StatusContext")
+}
+
+func (c *Client) status(ctx context.Context, method string) (*igdb.Status, error) {
+	c.calls.record("Client", method, nil)
+	if c.StatusFunc == nil {
+		return nil, notScripted("Client", method)
+	}
+
+	return c.StatusFunc(ctx)
+}
+
+// ResolveTags records the call and returns the response of ResolveTagsFunc.
+func (c *Client) ResolveTags(ctx context.Context, tags []igdb.Tag, opts ...igdb.Option) (*igdb.TaggedObjects, error) {
+	c.calls.record("Client", "ResolveTags", opts, tags)
+	if c.ResolveTagsFunc == nil {
+		return nil, notScripted("Client", "ResolveTags")
+	}
+
+	return c.ResolveTagsFunc(ctx, tags, opts...)
+}
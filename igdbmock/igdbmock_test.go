@@ -0,0 +1,169 @@
+package igdbmock
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/Henry-Sarabia/igdb"
+	"github.com/pkg/errors"
+)
+
+// topGame returns the name of the highest rated game, as an example
+// of code depending on igdb.API.
+func topGame(api igdb.API) (string, error) {
+	games, err := api.GamesAPI().Index(igdb.SetFields("name"), igdb.SetOrder("rating", igdb.OrderDescending), igdb.SetLimit(1))
+	if err != nil {
+		return "", err
+	}
+
+	return games[0].Name, nil
+}
+
+func TestClient_Scripted(t *testing.T) {
+	c := NewClient()
+	c.Games.IndexFunc = func(ctx context.Context, opts ...igdb.Option) ([]*igdb.Game, error) {
+		return []*igdb.Game{{ID: 1, Name: "Halo"}}, nil
+	}
+
+	got, err := topGame(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "Halo" {
+		t.Errorf("got: <%v>, want: <%v>", got, "Halo")
+	}
+
+	calls := c.Games.Calls()
+	if len(calls) != 1 {
+		t.Fatalf("got: <%v> calls, want: <%v>", len(calls), 1)
+	}
+
+	want := "fields name; sort rating desc; limit 1; "
+	if calls[0].Method != "Index" || calls[0].Query != want {
+		t.Errorf("got: <%v %q>, want: <%v %q>", calls[0].Method, calls[0].Query, "Index", want)
+	}
+
+	if !reflect.DeepEqual(c.Calls(), calls) {
+		t.Errorf("got: <%v>, want: <%v>", c.Calls(), calls)
+	}
+}
+
+func TestClient_NotScripted(t *testing.T) {
+	c := NewClient()
+
+	var tests = []struct {
+		name string
+		call func() error
+	}{
+		{"Get", func() error { _, err := c.Covers.Get(1); return err }},
+		{"ListContext", func() error { _, err := c.Covers.ListContext(context.Background(), []int{1}); return err }},
+		{"Search", func() error { _, err := c.Games.Search("halo"); return err }},
+		{"Count", func() error { _, err := c.Games.Count(); return err }},
+		{"Fields", func() error { _, err := c.Games.Fields(); return err }},
+		{"Client Search", func() error { _, err := c.Search("halo"); return err }},
+		{"Client Status", func() error { _, err := c.Status(); return err }},
+		{"Client ResolveTags", func() error { _, err := c.ResolveTags(context.Background(), nil); return err }},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.call()
+			if errors.Cause(err) != ErrNotScripted {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), ErrNotScripted)
+			}
+		})
+	}
+}
+
+func TestClient_Calls(t *testing.T) {
+	c := NewClient()
+	ctx := context.Background()
+
+	c.Covers.Get(5, igdb.SetFields("image_id"))
+	c.Games.ListContext(ctx, []int{1, 2})
+	c.Games.SearchContext(ctx, "zelda")
+	c.Status()
+
+	var tests = []struct {
+		name string
+		got  []Call
+		want []Call
+	}{
+		{
+			"Covers",
+			c.Covers.Calls(),
+			[]Call{{Mock: "CoverAPI", Method: "Get", Args: []interface{}{5}, Query: "fields image_id; "}},
+		},
+		{
+			"Games",
+			c.Games.Calls(),
+			[]Call{
+				{Mock: "GameAPI", Method: "ListContext", Args: []interface{}{[]int{1, 2}}},
+				{Mock: "GameAPI", Method: "SearchContext", Args: []interface{}{"zelda"}},
+			},
+		},
+		{
+			"Client",
+			c.Calls(),
+			[]Call{
+				{Mock: "CoverAPI", Method: "Get", Args: []interface{}{5}, Query: "fields image_id; "},
+				{Mock: "GameAPI", Method: "ListContext", Args: []interface{}{[]int{1, 2}}},
+				{Mock: "GameAPI", Method: "SearchContext", Args: []interface{}{"zelda"}},
+				{Mock: "Client", Method: "Status"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if len(test.got) != len(test.want) {
+				t.Fatalf("got: <%v>, want: <%v>", test.got, test.want)
+			}
+
+			for i := range test.got {
+				got, want := test.got[i], test.want[i]
+				if got.Mock != want.Mock || got.Method != want.Method || got.Query != want.Query || !reflect.DeepEqual(got.Args, want.Args) {
+					t.Errorf("got: <%v>, want: <%v>", got, want)
+				}
+			}
+		})
+	}
+
+	c.Games.Reset()
+	if n := len(c.Games.Calls()); n != 0 {
+		t.Errorf("got: <%v> calls, want: <%v>", n, 0)
+	}
+	if n := len(c.Calls()); n != 4 {
+		t.Errorf("got: <%v> calls, want: <%v>", n, 4)
+	}
+}
+
+func TestGameAPI_ZeroValue(t *testing.T) {
+	m := &GameAPI{
+		GetFunc: func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.Game, error) {
+			if id != 7 {
+				return nil, igdb.ErrNotFound
+			}
+			return &igdb.Game{ID: id}, nil
+		},
+	}
+
+	var api igdb.GameAPI = m
+
+	g, err := api.GetContext(context.Background(), 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.ID != 7 {
+		t.Errorf("got: <%v>, want: <%v>", g.ID, 7)
+	}
+
+	if _, err := api.Get(8); err != igdb.ErrNotFound {
+		t.Errorf("got: <%v>, want: <%v>", err, igdb.ErrNotFound)
+	}
+
+	if n := len(m.Calls()); n != 2 {
+		t.Errorf("got: <%v> calls, want: <%v>", n, 2)
+	}
+}
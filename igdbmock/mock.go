@@ -0,0 +1,8172 @@
+// Code generated by "go run ./internal/apigen"; DO NOT EDIT.
+
+package igdbmock
+
+import (
+	"context"
+
+	"github.com/Henry-Sarabia/igdb"
+)
+
+// Client is a mock igdb.API. Every service of the Client is a mock of its own,
+// created by NewClient, and the calls to every service are recorded by the
+// Client as well. Set the Func fields of the Client and of its services to
+// script their responses.
+type Client struct {
+	Achievements                *AchievementAPI
+	AchievementIcons            *AchievementIconAPI
+	AgeRatings                  *AgeRatingAPI
+	AgeRatingContents           *AgeRatingContentAPI
+	AlternativeNames            *AlternativeNameAPI
+	Artworks                    *ArtworkAPI
+	Characters                  *CharacterAPI
+	CharacterMugshots           *CharacterMugshotAPI
+	Collections                 *CollectionAPI
+	Companies                   *CompanyAPI
+	CompanyLogos                *CompanyLogoAPI
+	CompanyWebsites             *CompanyWebsiteAPI
+	Covers                      *CoverAPI
+	ExternalGames               *ExternalGameAPI
+	Feeds                       *FeedAPI
+	Franchises                  *FranchiseAPI
+	Games                       *GameAPI
+	GameEngines                 *GameEngineAPI
+	GameEngineLogos             *GameEngineLogoAPI
+	GameModes                   *GameModeAPI
+	GameVersions                *GameVersionAPI
+	GameVersionFeatures         *GameVersionFeatureAPI
+	GameVersionFeatureValues    *GameVersionFeatureValueAPI
+	GameVideos                  *GameVideoAPI
+	Genres                      *GenreAPI
+	InvolvedCompanies           *InvolvedCompanyAPI
+	Keywords                    *KeywordAPI
+	MultiplayerModes            *MultiplayerModeAPI
+	Pages                       *PageAPI
+	PageBackgrounds             *PageBackgroundAPI
+	PageLogos                   *PageLogoAPI
+	PageWebsites                *PageWebsiteAPI
+	Platforms                   *PlatformAPI
+	PlatformLogos               *PlatformLogoAPI
+	PlatformVersions            *PlatformVersionAPI
+	PlatformVersionCompanies    *PlatformVersionCompanyAPI
+	PlatformVersionReleaseDates *PlatformVersionReleaseDateAPI
+	PlatformWebsites            *PlatformWebsiteAPI
+	PlayerPerspectives          *PlayerPerspectiveAPI
+	ProductFamilies             *ProductFamilyAPI
+	Pulses                      *PulseAPI
+	PulseGroups                 *PulseGroupAPI
+	PulseSources                *PulseSourceAPI
+	PulseURLs                   *PulseURLAPI
+	ReleaseDates                *ReleaseDateAPI
+	Screenshots                 *ScreenshotAPI
+	Themes                      *ThemeAPI
+	TimeToBeats                 *TimeToBeatAPI
+	Titles                      *TitleAPI
+	Websites                    *WebsiteAPI
+	Credits                     *CreditAPI
+	FeedFollows                 *FeedFollowAPI
+	Follows                     *FollowAPI
+	Lists                       *ListAPI
+	ListEntrys                  *ListEntryAPI
+	Persons                     *PersonAPI
+	PersonMugshots              *PersonMugshotAPI
+	PersonWebsites              *PersonWebsiteAPI
+	Rates                       *RateAPI
+	Reviews                     *ReviewAPI
+	ReviewVideos                *ReviewVideoAPI
+	SocialMetrics               *SocialMetricAPI
+	TestDummies                 *TestDummyAPI
+
+	SearchFunc      func(ctx context.Context, qry string, opts ...igdb.Option) ([]*igdb.SearchResult, error)
+	StatusFunc      func(ctx context.Context) (*igdb.Status, error)
+	ResolveTagsFunc func(ctx context.Context, tags []igdb.Tag, opts ...igdb.Option) (*igdb.TaggedObjects, error)
+
+	calls callLog
+}
+
+var _ igdb.API = (*Client)(nil)
+
+// NewClient returns a new Client with a mock for every service.
+func NewClient() *Client {
+	c := &Client{}
+	c.Achievements = &AchievementAPI{calls: callLog{parent: &c.calls}}
+	c.AchievementIcons = &AchievementIconAPI{calls: callLog{parent: &c.calls}}
+	c.AgeRatings = &AgeRatingAPI{calls: callLog{parent: &c.calls}}
+	c.AgeRatingContents = &AgeRatingContentAPI{calls: callLog{parent: &c.calls}}
+	c.AlternativeNames = &AlternativeNameAPI{calls: callLog{parent: &c.calls}}
+	c.Artworks = &ArtworkAPI{calls: callLog{parent: &c.calls}}
+	c.Characters = &CharacterAPI{calls: callLog{parent: &c.calls}}
+	c.CharacterMugshots = &CharacterMugshotAPI{calls: callLog{parent: &c.calls}}
+	c.Collections = &CollectionAPI{calls: callLog{parent: &c.calls}}
+	c.Companies = &CompanyAPI{calls: callLog{parent: &c.calls}}
+	c.CompanyLogos = &CompanyLogoAPI{calls: callLog{parent: &c.calls}}
+	c.CompanyWebsites = &CompanyWebsiteAPI{calls: callLog{parent: &c.calls}}
+	c.Covers = &CoverAPI{calls: callLog{parent: &c.calls}}
+	c.ExternalGames = &ExternalGameAPI{calls: callLog{parent: &c.calls}}
+	c.Feeds = &FeedAPI{calls: callLog{parent: &c.calls}}
+	c.Franchises = &FranchiseAPI{calls: callLog{parent: &c.calls}}
+	c.Games = &GameAPI{calls: callLog{parent: &c.calls}}
+	c.GameEngines = &GameEngineAPI{calls: callLog{parent: &c.calls}}
+	c.GameEngineLogos = &GameEngineLogoAPI{calls: callLog{parent: &c.calls}}
+	c.GameModes = &GameModeAPI{calls: callLog{parent: &c.calls}}
+	c.GameVersions = &GameVersionAPI{calls: callLog{parent: &c.calls}}
+	c.GameVersionFeatures = &GameVersionFeatureAPI{calls: callLog{parent: &c.calls}}
+	c.GameVersionFeatureValues = &GameVersionFeatureValueAPI{calls: callLog{parent: &c.calls}}
+	c.GameVideos = &GameVideoAPI{calls: callLog{parent: &c.calls}}
+	c.Genres = &GenreAPI{calls: callLog{parent: &c.calls}}
+	c.InvolvedCompanies = &InvolvedCompanyAPI{calls: callLog{parent: &c.calls}}
+	c.Keywords = &KeywordAPI{calls: callLog{parent: &c.calls}}
+	c.MultiplayerModes = &MultiplayerModeAPI{calls: callLog{parent: &c.calls}}
+	c.Pages = &PageAPI{calls: callLog{parent: &c.calls}}
+	c.PageBackgrounds = &PageBackgroundAPI{calls: callLog{parent: &c.calls}}
+	c.PageLogos = &PageLogoAPI{calls: callLog{parent: &c.calls}}
+	c.PageWebsites = &PageWebsiteAPI{calls: callLog{parent: &c.calls}}
+	c.Platforms = &PlatformAPI{calls: callLog{parent: &c.calls}}
+	c.PlatformLogos = &PlatformLogoAPI{calls: callLog{parent: &c.calls}}
+	c.PlatformVersions = &PlatformVersionAPI{calls: callLog{parent: &c.calls}}
+	c.PlatformVersionCompanies = &PlatformVersionCompanyAPI{calls: callLog{parent: &c.calls}}
+	c.PlatformVersionReleaseDates = &PlatformVersionReleaseDateAPI{calls: callLog{parent: &c.calls}}
+	c.PlatformWebsites = &PlatformWebsiteAPI{calls: callLog{parent: &c.calls}}
+	c.PlayerPerspectives = &PlayerPerspectiveAPI{calls: callLog{parent: &c.calls}}
+	c.ProductFamilies = &ProductFamilyAPI{calls: callLog{parent: &c.calls}}
+	c.Pulses = &PulseAPI{calls: callLog{parent: &c.calls}}
+	c.PulseGroups = &PulseGroupAPI{calls: callLog{parent: &c.calls}}
+	c.PulseSources = &PulseSourceAPI{calls: callLog{parent: &c.calls}}
+	c.PulseURLs = &PulseURLAPI{calls: callLog{parent: &c.calls}}
+	c.ReleaseDates = &ReleaseDateAPI{calls: callLog{parent: &c.calls}}
+	c.Screenshots = &ScreenshotAPI{calls: callLog{parent: &c.calls}}
+	c.Themes = &ThemeAPI{calls: callLog{parent: &c.calls}}
+	c.TimeToBeats = &TimeToBeatAPI{calls: callLog{parent: &c.calls}}
+	c.Titles = &TitleAPI{calls: callLog{parent: &c.calls}}
+	c.Websites = &WebsiteAPI{calls: callLog{parent: &c.calls}}
+	c.Credits = &CreditAPI{calls: callLog{parent: &c.calls}}
+	c.FeedFollows = &FeedFollowAPI{calls: callLog{parent: &c.calls}}
+	c.Follows = &FollowAPI{calls: callLog{parent: &c.calls}}
+	c.Lists = &ListAPI{calls: callLog{parent: &c.calls}}
+	c.ListEntrys = &ListEntryAPI{calls: callLog{parent: &c.calls}}
+	c.Persons = &PersonAPI{calls: callLog{parent: &c.calls}}
+	c.PersonMugshots = &PersonMugshotAPI{calls: callLog{parent: &c.calls}}
+	c.PersonWebsites = &PersonWebsiteAPI{calls: callLog{parent: &c.calls}}
+	c.Rates = &RateAPI{calls: callLog{parent: &c.calls}}
+	c.Reviews = &ReviewAPI{calls: callLog{parent: &c.calls}}
+	c.ReviewVideos = &ReviewVideoAPI{calls: callLog{parent: &c.calls}}
+	c.SocialMetrics = &SocialMetricAPI{calls: callLog{parent: &c.calls}}
+	c.TestDummies = &TestDummyAPI{calls: callLog{parent: &c.calls}}
+
+	return c
+}
+
+// AchievementsAPI returns the Achievements mock of the Client.
+func (c *Client) AchievementsAPI() igdb.AchievementAPI {
+	return c.Achievements
+}
+
+// AchievementIconsAPI returns the AchievementIcons mock of the Client.
+func (c *Client) AchievementIconsAPI() igdb.AchievementIconAPI {
+	return c.AchievementIcons
+}
+
+// AgeRatingsAPI returns the AgeRatings mock of the Client.
+func (c *Client) AgeRatingsAPI() igdb.AgeRatingAPI {
+	return c.AgeRatings
+}
+
+// AgeRatingContentsAPI returns the AgeRatingContents mock of the Client.
+func (c *Client) AgeRatingContentsAPI() igdb.AgeRatingContentAPI {
+	return c.AgeRatingContents
+}
+
+// AlternativeNamesAPI returns the AlternativeNames mock of the Client.
+func (c *Client) AlternativeNamesAPI() igdb.AlternativeNameAPI {
+	return c.AlternativeNames
+}
+
+// ArtworksAPI returns the Artworks mock of the Client.
+func (c *Client) ArtworksAPI() igdb.ArtworkAPI {
+	return c.Artworks
+}
+
+// CharactersAPI returns the Characters mock of the Client.
+func (c *Client) CharactersAPI() igdb.CharacterAPI {
+	return c.Characters
+}
+
+// CharacterMugshotsAPI returns the CharacterMugshots mock of the Client.
+func (c *Client) CharacterMugshotsAPI() igdb.CharacterMugshotAPI {
+	return c.CharacterMugshots
+}
+
+// CollectionsAPI returns the Collections mock of the Client.
+func (c *Client) CollectionsAPI() igdb.CollectionAPI {
+	return c.Collections
+}
+
+// CompaniesAPI returns the Companies mock of the Client.
+func (c *Client) CompaniesAPI() igdb.CompanyAPI {
+	return c.Companies
+}
+
+// CompanyLogosAPI returns the CompanyLogos mock of the Client.
+func (c *Client) CompanyLogosAPI() igdb.CompanyLogoAPI {
+	return c.CompanyLogos
+}
+
+// CompanyWebsitesAPI returns the CompanyWebsites mock of the Client.
+func (c *Client) CompanyWebsitesAPI() igdb.CompanyWebsiteAPI {
+	return c.CompanyWebsites
+}
+
+// CoversAPI returns the Covers mock of the Client.
+func (c *Client) CoversAPI() igdb.CoverAPI {
+	return c.Covers
+}
+
+// ExternalGamesAPI returns the ExternalGames mock of the Client.
+func (c *Client) ExternalGamesAPI() igdb.ExternalGameAPI {
+	return c.ExternalGames
+}
+
+// FeedsAPI returns the Feeds mock of the Client.
+func (c *Client) FeedsAPI() igdb.FeedAPI {
+	return c.Feeds
+}
+
+// FranchisesAPI returns the Franchises mock of the Client.
+func (c *Client) FranchisesAPI() igdb.FranchiseAPI {
+	return c.Franchises
+}
+
+// GamesAPI returns the Games mock of the Client.
+func (c *Client) GamesAPI() igdb.GameAPI {
+	return c.Games
+}
+
+// GameEnginesAPI returns the GameEngines mock of the Client.
+func (c *Client) GameEnginesAPI() igdb.GameEngineAPI {
+	return c.GameEngines
+}
+
+// GameEngineLogosAPI returns the GameEngineLogos mock of the Client.
+func (c *Client) GameEngineLogosAPI() igdb.GameEngineLogoAPI {
+	return c.GameEngineLogos
+}
+
+// GameModesAPI returns the GameModes mock of the Client.
+func (c *Client) GameModesAPI() igdb.GameModeAPI {
+	return c.GameModes
+}
+
+// GameVersionsAPI returns the GameVersions mock of the Client.
+func (c *Client) GameVersionsAPI() igdb.GameVersionAPI {
+	return c.GameVersions
+}
+
+// GameVersionFeaturesAPI returns the GameVersionFeatures mock of the Client.
+func (c *Client) GameVersionFeaturesAPI() igdb.GameVersionFeatureAPI {
+	return c.GameVersionFeatures
+}
+
+// GameVersionFeatureValuesAPI returns the GameVersionFeatureValues mock of the Client.
+func (c *Client) GameVersionFeatureValuesAPI() igdb.GameVersionFeatureValueAPI {
+	return c.GameVersionFeatureValues
+}
+
+// GameVideosAPI returns the GameVideos mock of the Client.
+func (c *Client) GameVideosAPI() igdb.GameVideoAPI {
+	return c.GameVideos
+}
+
+// GenresAPI returns the Genres mock of the Client.
+func (c *Client) GenresAPI() igdb.GenreAPI {
+	return c.Genres
+}
+
+// InvolvedCompaniesAPI returns the InvolvedCompanies mock of the Client.
+func (c *Client) InvolvedCompaniesAPI() igdb.InvolvedCompanyAPI {
+	return c.InvolvedCompanies
+}
+
+// KeywordsAPI returns the Keywords mock of the Client.
+func (c *Client) KeywordsAPI() igdb.KeywordAPI {
+	return c.Keywords
+}
+
+// MultiplayerModesAPI returns the MultiplayerModes mock of the Client.
+func (c *Client) MultiplayerModesAPI() igdb.MultiplayerModeAPI {
+	return c.MultiplayerModes
+}
+
+// PagesAPI returns the Pages mock of the Client.
+func (c *Client) PagesAPI() igdb.PageAPI {
+	return c.Pages
+}
+
+// PageBackgroundsAPI returns the PageBackgrounds mock of the Client.
+func (c *Client) PageBackgroundsAPI() igdb.PageBackgroundAPI {
+	return c.PageBackgrounds
+}
+
+// PageLogosAPI returns the PageLogos mock of the Client.
+func (c *Client) PageLogosAPI() igdb.PageLogoAPI {
+	return c.PageLogos
+}
+
+// PageWebsitesAPI returns the PageWebsites mock of the Client.
+func (c *Client) PageWebsitesAPI() igdb.PageWebsiteAPI {
+	return c.PageWebsites
+}
+
+// PlatformsAPI returns the Platforms mock of the Client.
+func (c *Client) PlatformsAPI() igdb.PlatformAPI {
+	return c.Platforms
+}
+
+// PlatformLogosAPI returns the PlatformLogos mock of the Client.
+func (c *Client) PlatformLogosAPI() igdb.PlatformLogoAPI {
+	return c.PlatformLogos
+}
+
+// PlatformVersionsAPI returns the PlatformVersions mock of the Client.
+func (c *Client) PlatformVersionsAPI() igdb.PlatformVersionAPI {
+	return c.PlatformVersions
+}
+
+// PlatformVersionCompaniesAPI returns the PlatformVersionCompanies mock of the Client.
+func (c *Client) PlatformVersionCompaniesAPI() igdb.PlatformVersionCompanyAPI {
+	return c.PlatformVersionCompanies
+}
+
+// PlatformVersionReleaseDatesAPI returns the PlatformVersionReleaseDates mock of the Client.
+func (c *Client) PlatformVersionReleaseDatesAPI() igdb.PlatformVersionReleaseDateAPI {
+	return c.PlatformVersionReleaseDates
+}
+
+// PlatformWebsitesAPI returns the PlatformWebsites mock of the Client.
+func (c *Client) PlatformWebsitesAPI() igdb.PlatformWebsiteAPI {
+	return c.PlatformWebsites
+}
+
+// PlayerPerspectivesAPI returns the PlayerPerspectives mock of the Client.
+func (c *Client) PlayerPerspectivesAPI() igdb.PlayerPerspectiveAPI {
+	return c.PlayerPerspectives
+}
+
+// ProductFamiliesAPI returns the ProductFamilies mock of the Client.
+func (c *Client) ProductFamiliesAPI() igdb.ProductFamilyAPI {
+	return c.ProductFamilies
+}
+
+// PulsesAPI returns the Pulses mock of the Client.
+func (c *Client) PulsesAPI() igdb.PulseAPI {
+	return c.Pulses
+}
+
+// PulseGroupsAPI returns the PulseGroups mock of the Client.
+func (c *Client) PulseGroupsAPI() igdb.PulseGroupAPI {
+	return c.PulseGroups
+}
+
+// PulseSourcesAPI returns the PulseSources mock of the Client.
+func (c *Client) PulseSourcesAPI() igdb.PulseSourceAPI {
+	return c.PulseSources
+}
+
+// PulseURLsAPI returns the PulseURLs mock of the Client.
+func (c *Client) PulseURLsAPI() igdb.PulseURLAPI {
+	return c.PulseURLs
+}
+
+// ReleaseDatesAPI returns the ReleaseDates mock of the Client.
+func (c *Client) ReleaseDatesAPI() igdb.ReleaseDateAPI {
+	return c.ReleaseDates
+}
+
+// ScreenshotsAPI returns the Screenshots mock of the Client.
+func (c *Client) ScreenshotsAPI() igdb.ScreenshotAPI {
+	return c.Screenshots
+}
+
+// ThemesAPI returns the Themes mock of the Client.
+func (c *Client) ThemesAPI() igdb.ThemeAPI {
+	return c.Themes
+}
+
+// TimeToBeatsAPI returns the TimeToBeats mock of the Client.
+func (c *Client) TimeToBeatsAPI() igdb.TimeToBeatAPI {
+	return c.TimeToBeats
+}
+
+// TitlesAPI returns the Titles mock of the Client.
+func (c *Client) TitlesAPI() igdb.TitleAPI {
+	return c.Titles
+}
+
+// WebsitesAPI returns the Websites mock of the Client.
+func (c *Client) WebsitesAPI() igdb.WebsiteAPI {
+	return c.Websites
+}
+
+// CreditsAPI returns the Credits mock of the Client.
+func (c *Client) CreditsAPI() igdb.CreditAPI {
+	return c.Credits
+}
+
+// FeedFollowsAPI returns the FeedFollows mock of the Client.
+func (c *Client) FeedFollowsAPI() igdb.FeedFollowAPI {
+	return c.FeedFollows
+}
+
+// FollowsAPI returns the Follows mock of the Client.
+func (c *Client) FollowsAPI() igdb.FollowAPI {
+	return c.Follows
+}
+
+// ListsAPI returns the Lists mock of the Client.
+func (c *Client) ListsAPI() igdb.ListAPI {
+	return c.Lists
+}
+
+// ListEntrysAPI returns the ListEntrys mock of the Client.
+func (c *Client) ListEntrysAPI() igdb.ListEntryAPI {
+	return c.ListEntrys
+}
+
+// PersonsAPI returns the Persons mock of the Client.
+func (c *Client) PersonsAPI() igdb.PersonAPI {
+	return c.Persons
+}
+
+// PersonMugshotsAPI returns the PersonMugshots mock of the Client.
+func (c *Client) PersonMugshotsAPI() igdb.PersonMugshotAPI {
+	return c.PersonMugshots
+}
+
+// PersonWebsitesAPI returns the PersonWebsites mock of the Client.
+func (c *Client) PersonWebsitesAPI() igdb.PersonWebsiteAPI {
+	return c.PersonWebsites
+}
+
+// RatesAPI returns the Rates mock of the Client.
+func (c *Client) RatesAPI() igdb.RateAPI {
+	return c.Rates
+}
+
+// ReviewsAPI returns the Reviews mock of the Client.
+func (c *Client) ReviewsAPI() igdb.ReviewAPI {
+	return c.Reviews
+}
+
+// ReviewVideosAPI returns the ReviewVideos mock of the Client.
+func (c *Client) ReviewVideosAPI() igdb.ReviewVideoAPI {
+	return c.ReviewVideos
+}
+
+// SocialMetricsAPI returns the SocialMetrics mock of the Client.
+func (c *Client) SocialMetricsAPI() igdb.SocialMetricAPI {
+	return c.SocialMetrics
+}
+
+// TestDummiesAPI returns the TestDummies mock of the Client.
+func (c *Client) TestDummiesAPI() igdb.TestDummyAPI {
+	return c.TestDummies
+}
+
+// AchievementAPI is a mock igdb.AchievementAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type AchievementAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.Achievement, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.Achievement, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.Achievement, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.AchievementAPI = (*AchievementAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *AchievementAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *AchievementAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *AchievementAPI) Get(id int, opts ...igdb.Option) (*igdb.Achievement, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *AchievementAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.Achievement, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *AchievementAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.Achievement, error) {
+	m.calls.record("AchievementAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("AchievementAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *AchievementAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.Achievement, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *AchievementAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.Achievement, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *AchievementAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.Achievement, error) {
+	m.calls.record("AchievementAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("AchievementAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *AchievementAPI) Index(opts ...igdb.Option) ([]*igdb.Achievement, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *AchievementAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.Achievement, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *AchievementAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.Achievement, error) {
+	m.calls.record("AchievementAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("AchievementAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *AchievementAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *AchievementAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *AchievementAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("AchievementAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("AchievementAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *AchievementAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *AchievementAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *AchievementAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("AchievementAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("AchievementAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// AchievementIconAPI is a mock igdb.AchievementIconAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type AchievementIconAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.AchievementIcon, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.AchievementIcon, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.AchievementIcon, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.AchievementIconAPI = (*AchievementIconAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *AchievementIconAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *AchievementIconAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *AchievementIconAPI) Get(id int, opts ...igdb.Option) (*igdb.AchievementIcon, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *AchievementIconAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.AchievementIcon, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *AchievementIconAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.AchievementIcon, error) {
+	m.calls.record("AchievementIconAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("AchievementIconAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *AchievementIconAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.AchievementIcon, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *AchievementIconAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.AchievementIcon, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *AchievementIconAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.AchievementIcon, error) {
+	m.calls.record("AchievementIconAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("AchievementIconAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *AchievementIconAPI) Index(opts ...igdb.Option) ([]*igdb.AchievementIcon, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *AchievementIconAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.AchievementIcon, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *AchievementIconAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.AchievementIcon, error) {
+	m.calls.record("AchievementIconAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("AchievementIconAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *AchievementIconAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *AchievementIconAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *AchievementIconAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("AchievementIconAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("AchievementIconAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *AchievementIconAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *AchievementIconAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *AchievementIconAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("AchievementIconAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("AchievementIconAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// AgeRatingAPI is a mock igdb.AgeRatingAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type AgeRatingAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.AgeRating, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.AgeRating, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.AgeRating, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.AgeRatingAPI = (*AgeRatingAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *AgeRatingAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *AgeRatingAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *AgeRatingAPI) Get(id int, opts ...igdb.Option) (*igdb.AgeRating, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *AgeRatingAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.AgeRating, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *AgeRatingAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.AgeRating, error) {
+	m.calls.record("AgeRatingAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("AgeRatingAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *AgeRatingAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.AgeRating, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *AgeRatingAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.AgeRating, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *AgeRatingAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.AgeRating, error) {
+	m.calls.record("AgeRatingAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("AgeRatingAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *AgeRatingAPI) Index(opts ...igdb.Option) ([]*igdb.AgeRating, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *AgeRatingAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.AgeRating, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *AgeRatingAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.AgeRating, error) {
+	m.calls.record("AgeRatingAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("AgeRatingAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *AgeRatingAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *AgeRatingAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *AgeRatingAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("AgeRatingAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("AgeRatingAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *AgeRatingAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *AgeRatingAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *AgeRatingAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("AgeRatingAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("AgeRatingAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// AgeRatingContentAPI is a mock igdb.AgeRatingContentAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type AgeRatingContentAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.AgeRatingContent, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.AgeRatingContent, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.AgeRatingContent, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.AgeRatingContentAPI = (*AgeRatingContentAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *AgeRatingContentAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *AgeRatingContentAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *AgeRatingContentAPI) Get(id int, opts ...igdb.Option) (*igdb.AgeRatingContent, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *AgeRatingContentAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.AgeRatingContent, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *AgeRatingContentAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.AgeRatingContent, error) {
+	m.calls.record("AgeRatingContentAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("AgeRatingContentAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *AgeRatingContentAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.AgeRatingContent, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *AgeRatingContentAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.AgeRatingContent, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *AgeRatingContentAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.AgeRatingContent, error) {
+	m.calls.record("AgeRatingContentAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("AgeRatingContentAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *AgeRatingContentAPI) Index(opts ...igdb.Option) ([]*igdb.AgeRatingContent, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *AgeRatingContentAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.AgeRatingContent, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *AgeRatingContentAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.AgeRatingContent, error) {
+	m.calls.record("AgeRatingContentAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("AgeRatingContentAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *AgeRatingContentAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *AgeRatingContentAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *AgeRatingContentAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("AgeRatingContentAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("AgeRatingContentAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *AgeRatingContentAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *AgeRatingContentAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *AgeRatingContentAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("AgeRatingContentAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("AgeRatingContentAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// AlternativeNameAPI is a mock igdb.AlternativeNameAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type AlternativeNameAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.AlternativeName, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.AlternativeName, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.AlternativeName, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.AlternativeNameAPI = (*AlternativeNameAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *AlternativeNameAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *AlternativeNameAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *AlternativeNameAPI) Get(id int, opts ...igdb.Option) (*igdb.AlternativeName, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *AlternativeNameAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.AlternativeName, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *AlternativeNameAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.AlternativeName, error) {
+	m.calls.record("AlternativeNameAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("AlternativeNameAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *AlternativeNameAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.AlternativeName, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *AlternativeNameAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.AlternativeName, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *AlternativeNameAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.AlternativeName, error) {
+	m.calls.record("AlternativeNameAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("AlternativeNameAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *AlternativeNameAPI) Index(opts ...igdb.Option) ([]*igdb.AlternativeName, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *AlternativeNameAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.AlternativeName, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *AlternativeNameAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.AlternativeName, error) {
+	m.calls.record("AlternativeNameAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("AlternativeNameAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *AlternativeNameAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *AlternativeNameAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *AlternativeNameAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("AlternativeNameAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("AlternativeNameAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *AlternativeNameAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *AlternativeNameAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *AlternativeNameAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("AlternativeNameAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("AlternativeNameAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// ArtworkAPI is a mock igdb.ArtworkAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type ArtworkAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.Artwork, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.Artwork, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.Artwork, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.ArtworkAPI = (*ArtworkAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *ArtworkAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *ArtworkAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *ArtworkAPI) Get(id int, opts ...igdb.Option) (*igdb.Artwork, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *ArtworkAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.Artwork, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *ArtworkAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.Artwork, error) {
+	m.calls.record("ArtworkAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("ArtworkAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *ArtworkAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.Artwork, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *ArtworkAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.Artwork, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *ArtworkAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.Artwork, error) {
+	m.calls.record("ArtworkAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("ArtworkAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *ArtworkAPI) Index(opts ...igdb.Option) ([]*igdb.Artwork, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *ArtworkAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.Artwork, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *ArtworkAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.Artwork, error) {
+	m.calls.record("ArtworkAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("ArtworkAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *ArtworkAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *ArtworkAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *ArtworkAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("ArtworkAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("ArtworkAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *ArtworkAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *ArtworkAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *ArtworkAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("ArtworkAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("ArtworkAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// CharacterAPI is a mock igdb.CharacterAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type CharacterAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.Character, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.Character, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.Character, error)
+	SearchFunc func(ctx context.Context, qry string, opts ...igdb.Option) ([]*igdb.Character, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.CharacterAPI = (*CharacterAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *CharacterAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *CharacterAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *CharacterAPI) Get(id int, opts ...igdb.Option) (*igdb.Character, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *CharacterAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.Character, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *CharacterAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.Character, error) {
+	m.calls.record("CharacterAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("CharacterAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *CharacterAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.Character, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *CharacterAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.Character, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *CharacterAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.Character, error) {
+	m.calls.record("CharacterAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("CharacterAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *CharacterAPI) Index(opts ...igdb.Option) ([]*igdb.Character, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *CharacterAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.Character, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *CharacterAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.Character, error) {
+	m.calls.record("CharacterAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("CharacterAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Search records the call and returns the response of SearchFunc.
+func (m *CharacterAPI) Search(qry string, opts ...igdb.Option) ([]*igdb.Character, error) {
+	return m.search(context.Background(), "Search", qry, opts...)
+}
+
+// SearchContext records the call and returns the response of SearchFunc.
+func (m *CharacterAPI) SearchContext(ctx context.Context, qry string, opts ...igdb.Option) ([]*igdb.Character, error) {
+	return m.search(ctx, "SearchContext", qry, opts...)
+}
+
+func (m *CharacterAPI) search(ctx context.Context, method string, qry string, opts ...igdb.Option) ([]*igdb.Character, error) {
+	m.calls.record("CharacterAPI", method, opts, qry)
+	if m.SearchFunc == nil {
+		return nil, notScripted("CharacterAPI", method)
+	}
+
+	return m.SearchFunc(ctx, qry, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *CharacterAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *CharacterAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *CharacterAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("CharacterAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("CharacterAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *CharacterAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *CharacterAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *CharacterAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("CharacterAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("CharacterAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// CharacterMugshotAPI is a mock igdb.CharacterMugshotAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type CharacterMugshotAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.CharacterMugshot, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.CharacterMugshot, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.CharacterMugshot, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.CharacterMugshotAPI = (*CharacterMugshotAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *CharacterMugshotAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *CharacterMugshotAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *CharacterMugshotAPI) Get(id int, opts ...igdb.Option) (*igdb.CharacterMugshot, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *CharacterMugshotAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.CharacterMugshot, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *CharacterMugshotAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.CharacterMugshot, error) {
+	m.calls.record("CharacterMugshotAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("CharacterMugshotAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *CharacterMugshotAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.CharacterMugshot, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *CharacterMugshotAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.CharacterMugshot, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *CharacterMugshotAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.CharacterMugshot, error) {
+	m.calls.record("CharacterMugshotAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("CharacterMugshotAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *CharacterMugshotAPI) Index(opts ...igdb.Option) ([]*igdb.CharacterMugshot, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *CharacterMugshotAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.CharacterMugshot, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *CharacterMugshotAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.CharacterMugshot, error) {
+	m.calls.record("CharacterMugshotAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("CharacterMugshotAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *CharacterMugshotAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *CharacterMugshotAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *CharacterMugshotAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("CharacterMugshotAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("CharacterMugshotAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *CharacterMugshotAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *CharacterMugshotAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *CharacterMugshotAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("CharacterMugshotAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("CharacterMugshotAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// CollectionAPI is a mock igdb.CollectionAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type CollectionAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.Collection, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.Collection, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.Collection, error)
+	SearchFunc func(ctx context.Context, qry string, opts ...igdb.Option) ([]*igdb.Collection, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.CollectionAPI = (*CollectionAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *CollectionAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *CollectionAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *CollectionAPI) Get(id int, opts ...igdb.Option) (*igdb.Collection, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *CollectionAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.Collection, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *CollectionAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.Collection, error) {
+	m.calls.record("CollectionAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("CollectionAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *CollectionAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.Collection, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *CollectionAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.Collection, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *CollectionAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.Collection, error) {
+	m.calls.record("CollectionAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("CollectionAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *CollectionAPI) Index(opts ...igdb.Option) ([]*igdb.Collection, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *CollectionAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.Collection, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *CollectionAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.Collection, error) {
+	m.calls.record("CollectionAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("CollectionAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Search records the call and returns the response of SearchFunc.
+func (m *CollectionAPI) Search(qry string, opts ...igdb.Option) ([]*igdb.Collection, error) {
+	return m.search(context.Background(), "Search", qry, opts...)
+}
+
+// SearchContext records the call and returns the response of SearchFunc.
+func (m *CollectionAPI) SearchContext(ctx context.Context, qry string, opts ...igdb.Option) ([]*igdb.Collection, error) {
+	return m.search(ctx, "SearchContext", qry, opts...)
+}
+
+func (m *CollectionAPI) search(ctx context.Context, method string, qry string, opts ...igdb.Option) ([]*igdb.Collection, error) {
+	m.calls.record("CollectionAPI", method, opts, qry)
+	if m.SearchFunc == nil {
+		return nil, notScripted("CollectionAPI", method)
+	}
+
+	return m.SearchFunc(ctx, qry, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *CollectionAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *CollectionAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *CollectionAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("CollectionAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("CollectionAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *CollectionAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *CollectionAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *CollectionAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("CollectionAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("CollectionAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// CompanyAPI is a mock igdb.CompanyAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type CompanyAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.Company, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.Company, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.Company, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.CompanyAPI = (*CompanyAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *CompanyAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *CompanyAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *CompanyAPI) Get(id int, opts ...igdb.Option) (*igdb.Company, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *CompanyAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.Company, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *CompanyAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.Company, error) {
+	m.calls.record("CompanyAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("CompanyAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *CompanyAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.Company, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *CompanyAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.Company, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *CompanyAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.Company, error) {
+	m.calls.record("CompanyAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("CompanyAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *CompanyAPI) Index(opts ...igdb.Option) ([]*igdb.Company, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *CompanyAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.Company, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *CompanyAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.Company, error) {
+	m.calls.record("CompanyAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("CompanyAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *CompanyAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *CompanyAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *CompanyAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("CompanyAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("CompanyAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *CompanyAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *CompanyAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *CompanyAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("CompanyAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("CompanyAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// CompanyLogoAPI is a mock igdb.CompanyLogoAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type CompanyLogoAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.CompanyLogo, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.CompanyLogo, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.CompanyLogo, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.CompanyLogoAPI = (*CompanyLogoAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *CompanyLogoAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *CompanyLogoAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *CompanyLogoAPI) Get(id int, opts ...igdb.Option) (*igdb.CompanyLogo, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *CompanyLogoAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.CompanyLogo, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *CompanyLogoAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.CompanyLogo, error) {
+	m.calls.record("CompanyLogoAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("CompanyLogoAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *CompanyLogoAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.CompanyLogo, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *CompanyLogoAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.CompanyLogo, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *CompanyLogoAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.CompanyLogo, error) {
+	m.calls.record("CompanyLogoAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("CompanyLogoAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *CompanyLogoAPI) Index(opts ...igdb.Option) ([]*igdb.CompanyLogo, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *CompanyLogoAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.CompanyLogo, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *CompanyLogoAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.CompanyLogo, error) {
+	m.calls.record("CompanyLogoAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("CompanyLogoAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *CompanyLogoAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *CompanyLogoAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *CompanyLogoAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("CompanyLogoAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("CompanyLogoAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *CompanyLogoAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *CompanyLogoAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *CompanyLogoAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("CompanyLogoAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("CompanyLogoAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// CompanyWebsiteAPI is a mock igdb.CompanyWebsiteAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type CompanyWebsiteAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.CompanyWebsite, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.CompanyWebsite, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.CompanyWebsite, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.CompanyWebsiteAPI = (*CompanyWebsiteAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *CompanyWebsiteAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *CompanyWebsiteAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *CompanyWebsiteAPI) Get(id int, opts ...igdb.Option) (*igdb.CompanyWebsite, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *CompanyWebsiteAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.CompanyWebsite, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *CompanyWebsiteAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.CompanyWebsite, error) {
+	m.calls.record("CompanyWebsiteAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("CompanyWebsiteAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *CompanyWebsiteAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.CompanyWebsite, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *CompanyWebsiteAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.CompanyWebsite, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *CompanyWebsiteAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.CompanyWebsite, error) {
+	m.calls.record("CompanyWebsiteAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("CompanyWebsiteAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *CompanyWebsiteAPI) Index(opts ...igdb.Option) ([]*igdb.CompanyWebsite, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *CompanyWebsiteAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.CompanyWebsite, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *CompanyWebsiteAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.CompanyWebsite, error) {
+	m.calls.record("CompanyWebsiteAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("CompanyWebsiteAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *CompanyWebsiteAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *CompanyWebsiteAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *CompanyWebsiteAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("CompanyWebsiteAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("CompanyWebsiteAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *CompanyWebsiteAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *CompanyWebsiteAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *CompanyWebsiteAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("CompanyWebsiteAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("CompanyWebsiteAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// CoverAPI is a mock igdb.CoverAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type CoverAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.Cover, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.Cover, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.Cover, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.CoverAPI = (*CoverAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *CoverAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *CoverAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *CoverAPI) Get(id int, opts ...igdb.Option) (*igdb.Cover, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *CoverAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.Cover, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *CoverAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.Cover, error) {
+	m.calls.record("CoverAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("CoverAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *CoverAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.Cover, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *CoverAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.Cover, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *CoverAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.Cover, error) {
+	m.calls.record("CoverAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("CoverAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *CoverAPI) Index(opts ...igdb.Option) ([]*igdb.Cover, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *CoverAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.Cover, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *CoverAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.Cover, error) {
+	m.calls.record("CoverAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("CoverAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *CoverAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *CoverAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *CoverAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("CoverAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("CoverAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *CoverAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *CoverAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *CoverAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("CoverAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("CoverAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// ExternalGameAPI is a mock igdb.ExternalGameAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type ExternalGameAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.ExternalGame, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.ExternalGame, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.ExternalGame, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.ExternalGameAPI = (*ExternalGameAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *ExternalGameAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *ExternalGameAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *ExternalGameAPI) Get(id int, opts ...igdb.Option) (*igdb.ExternalGame, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *ExternalGameAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.ExternalGame, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *ExternalGameAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.ExternalGame, error) {
+	m.calls.record("ExternalGameAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("ExternalGameAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *ExternalGameAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.ExternalGame, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *ExternalGameAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.ExternalGame, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *ExternalGameAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.ExternalGame, error) {
+	m.calls.record("ExternalGameAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("ExternalGameAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *ExternalGameAPI) Index(opts ...igdb.Option) ([]*igdb.ExternalGame, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *ExternalGameAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.ExternalGame, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *ExternalGameAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.ExternalGame, error) {
+	m.calls.record("ExternalGameAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("ExternalGameAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *ExternalGameAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *ExternalGameAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *ExternalGameAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("ExternalGameAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("ExternalGameAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *ExternalGameAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *ExternalGameAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *ExternalGameAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("ExternalGameAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("ExternalGameAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// FeedAPI is a mock igdb.FeedAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type FeedAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.Feed, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.Feed, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.Feed, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.FeedAPI = (*FeedAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *FeedAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *FeedAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *FeedAPI) Get(id int, opts ...igdb.Option) (*igdb.Feed, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *FeedAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.Feed, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *FeedAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.Feed, error) {
+	m.calls.record("FeedAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("FeedAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *FeedAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.Feed, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *FeedAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.Feed, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *FeedAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.Feed, error) {
+	m.calls.record("FeedAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("FeedAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *FeedAPI) Index(opts ...igdb.Option) ([]*igdb.Feed, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *FeedAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.Feed, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *FeedAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.Feed, error) {
+	m.calls.record("FeedAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("FeedAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *FeedAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *FeedAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *FeedAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("FeedAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("FeedAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *FeedAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *FeedAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *FeedAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("FeedAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("FeedAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// FranchiseAPI is a mock igdb.FranchiseAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type FranchiseAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.Franchise, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.Franchise, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.Franchise, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.FranchiseAPI = (*FranchiseAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *FranchiseAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *FranchiseAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *FranchiseAPI) Get(id int, opts ...igdb.Option) (*igdb.Franchise, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *FranchiseAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.Franchise, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *FranchiseAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.Franchise, error) {
+	m.calls.record("FranchiseAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("FranchiseAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *FranchiseAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.Franchise, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *FranchiseAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.Franchise, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *FranchiseAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.Franchise, error) {
+	m.calls.record("FranchiseAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("FranchiseAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *FranchiseAPI) Index(opts ...igdb.Option) ([]*igdb.Franchise, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *FranchiseAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.Franchise, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *FranchiseAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.Franchise, error) {
+	m.calls.record("FranchiseAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("FranchiseAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *FranchiseAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *FranchiseAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *FranchiseAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("FranchiseAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("FranchiseAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *FranchiseAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *FranchiseAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *FranchiseAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("FranchiseAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("FranchiseAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// GameAPI is a mock igdb.GameAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type GameAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.Game, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.Game, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.Game, error)
+	SearchFunc func(ctx context.Context, qry string, opts ...igdb.Option) ([]*igdb.Game, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.GameAPI = (*GameAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *GameAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *GameAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *GameAPI) Get(id int, opts ...igdb.Option) (*igdb.Game, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *GameAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.Game, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *GameAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.Game, error) {
+	m.calls.record("GameAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("GameAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *GameAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.Game, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *GameAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.Game, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *GameAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.Game, error) {
+	m.calls.record("GameAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("GameAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *GameAPI) Index(opts ...igdb.Option) ([]*igdb.Game, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *GameAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.Game, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *GameAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.Game, error) {
+	m.calls.record("GameAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("GameAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Search records the call and returns the response of SearchFunc.
+func (m *GameAPI) Search(qry string, opts ...igdb.Option) ([]*igdb.Game, error) {
+	return m.search(context.Background(), "Search", qry, opts...)
+}
+
+// SearchContext records the call and returns the response of SearchFunc.
+func (m *GameAPI) SearchContext(ctx context.Context, qry string, opts ...igdb.Option) ([]*igdb.Game, error) {
+	return m.search(ctx, "SearchContext", qry, opts...)
+}
+
+func (m *GameAPI) search(ctx context.Context, method string, qry string, opts ...igdb.Option) ([]*igdb.Game, error) {
+	m.calls.record("GameAPI", method, opts, qry)
+	if m.SearchFunc == nil {
+		return nil, notScripted("GameAPI", method)
+	}
+
+	return m.SearchFunc(ctx, qry, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *GameAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *GameAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *GameAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("GameAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("GameAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *GameAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *GameAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *GameAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("GameAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("GameAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// GameEngineAPI is a mock igdb.GameEngineAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type GameEngineAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.GameEngine, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.GameEngine, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.GameEngine, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.GameEngineAPI = (*GameEngineAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *GameEngineAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *GameEngineAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *GameEngineAPI) Get(id int, opts ...igdb.Option) (*igdb.GameEngine, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *GameEngineAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.GameEngine, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *GameEngineAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.GameEngine, error) {
+	m.calls.record("GameEngineAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("GameEngineAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *GameEngineAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.GameEngine, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *GameEngineAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.GameEngine, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *GameEngineAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.GameEngine, error) {
+	m.calls.record("GameEngineAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("GameEngineAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *GameEngineAPI) Index(opts ...igdb.Option) ([]*igdb.GameEngine, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *GameEngineAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.GameEngine, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *GameEngineAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.GameEngine, error) {
+	m.calls.record("GameEngineAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("GameEngineAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *GameEngineAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *GameEngineAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *GameEngineAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("GameEngineAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("GameEngineAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *GameEngineAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *GameEngineAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *GameEngineAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("GameEngineAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("GameEngineAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// GameEngineLogoAPI is a mock igdb.GameEngineLogoAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type GameEngineLogoAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.GameEngineLogo, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.GameEngineLogo, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.GameEngineLogo, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.GameEngineLogoAPI = (*GameEngineLogoAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *GameEngineLogoAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *GameEngineLogoAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *GameEngineLogoAPI) Get(id int, opts ...igdb.Option) (*igdb.GameEngineLogo, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *GameEngineLogoAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.GameEngineLogo, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *GameEngineLogoAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.GameEngineLogo, error) {
+	m.calls.record("GameEngineLogoAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("GameEngineLogoAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *GameEngineLogoAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.GameEngineLogo, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *GameEngineLogoAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.GameEngineLogo, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *GameEngineLogoAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.GameEngineLogo, error) {
+	m.calls.record("GameEngineLogoAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("GameEngineLogoAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *GameEngineLogoAPI) Index(opts ...igdb.Option) ([]*igdb.GameEngineLogo, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *GameEngineLogoAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.GameEngineLogo, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *GameEngineLogoAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.GameEngineLogo, error) {
+	m.calls.record("GameEngineLogoAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("GameEngineLogoAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *GameEngineLogoAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *GameEngineLogoAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *GameEngineLogoAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("GameEngineLogoAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("GameEngineLogoAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *GameEngineLogoAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *GameEngineLogoAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *GameEngineLogoAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("GameEngineLogoAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("GameEngineLogoAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// GameModeAPI is a mock igdb.GameModeAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type GameModeAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.GameMode, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.GameMode, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.GameMode, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.GameModeAPI = (*GameModeAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *GameModeAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *GameModeAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *GameModeAPI) Get(id int, opts ...igdb.Option) (*igdb.GameMode, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *GameModeAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.GameMode, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *GameModeAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.GameMode, error) {
+	m.calls.record("GameModeAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("GameModeAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *GameModeAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.GameMode, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *GameModeAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.GameMode, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *GameModeAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.GameMode, error) {
+	m.calls.record("GameModeAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("GameModeAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *GameModeAPI) Index(opts ...igdb.Option) ([]*igdb.GameMode, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *GameModeAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.GameMode, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *GameModeAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.GameMode, error) {
+	m.calls.record("GameModeAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("GameModeAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *GameModeAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *GameModeAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *GameModeAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("GameModeAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("GameModeAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *GameModeAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *GameModeAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *GameModeAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("GameModeAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("GameModeAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// GameVersionAPI is a mock igdb.GameVersionAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type GameVersionAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.GameVersion, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.GameVersion, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.GameVersion, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.GameVersionAPI = (*GameVersionAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *GameVersionAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *GameVersionAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *GameVersionAPI) Get(id int, opts ...igdb.Option) (*igdb.GameVersion, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *GameVersionAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.GameVersion, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *GameVersionAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.GameVersion, error) {
+	m.calls.record("GameVersionAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("GameVersionAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *GameVersionAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.GameVersion, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *GameVersionAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.GameVersion, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *GameVersionAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.GameVersion, error) {
+	m.calls.record("GameVersionAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("GameVersionAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *GameVersionAPI) Index(opts ...igdb.Option) ([]*igdb.GameVersion, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *GameVersionAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.GameVersion, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *GameVersionAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.GameVersion, error) {
+	m.calls.record("GameVersionAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("GameVersionAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *GameVersionAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *GameVersionAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *GameVersionAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("GameVersionAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("GameVersionAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *GameVersionAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *GameVersionAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *GameVersionAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("GameVersionAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("GameVersionAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// GameVersionFeatureAPI is a mock igdb.GameVersionFeatureAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type GameVersionFeatureAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.GameVersionFeature, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.GameVersionFeature, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.GameVersionFeature, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.GameVersionFeatureAPI = (*GameVersionFeatureAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *GameVersionFeatureAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *GameVersionFeatureAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *GameVersionFeatureAPI) Get(id int, opts ...igdb.Option) (*igdb.GameVersionFeature, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *GameVersionFeatureAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.GameVersionFeature, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *GameVersionFeatureAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.GameVersionFeature, error) {
+	m.calls.record("GameVersionFeatureAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("GameVersionFeatureAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *GameVersionFeatureAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.GameVersionFeature, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *GameVersionFeatureAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.GameVersionFeature, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *GameVersionFeatureAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.GameVersionFeature, error) {
+	m.calls.record("GameVersionFeatureAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("GameVersionFeatureAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *GameVersionFeatureAPI) Index(opts ...igdb.Option) ([]*igdb.GameVersionFeature, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *GameVersionFeatureAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.GameVersionFeature, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *GameVersionFeatureAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.GameVersionFeature, error) {
+	m.calls.record("GameVersionFeatureAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("GameVersionFeatureAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *GameVersionFeatureAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *GameVersionFeatureAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *GameVersionFeatureAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("GameVersionFeatureAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("GameVersionFeatureAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *GameVersionFeatureAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *GameVersionFeatureAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *GameVersionFeatureAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("GameVersionFeatureAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("GameVersionFeatureAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// GameVersionFeatureValueAPI is a mock igdb.GameVersionFeatureValueAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type GameVersionFeatureValueAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.GameVersionFeatureValue, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.GameVersionFeatureValue, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.GameVersionFeatureValue, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.GameVersionFeatureValueAPI = (*GameVersionFeatureValueAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *GameVersionFeatureValueAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *GameVersionFeatureValueAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *GameVersionFeatureValueAPI) Get(id int, opts ...igdb.Option) (*igdb.GameVersionFeatureValue, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *GameVersionFeatureValueAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.GameVersionFeatureValue, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *GameVersionFeatureValueAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.GameVersionFeatureValue, error) {
+	m.calls.record("GameVersionFeatureValueAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("GameVersionFeatureValueAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *GameVersionFeatureValueAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.GameVersionFeatureValue, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *GameVersionFeatureValueAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.GameVersionFeatureValue, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *GameVersionFeatureValueAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.GameVersionFeatureValue, error) {
+	m.calls.record("GameVersionFeatureValueAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("GameVersionFeatureValueAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *GameVersionFeatureValueAPI) Index(opts ...igdb.Option) ([]*igdb.GameVersionFeatureValue, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *GameVersionFeatureValueAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.GameVersionFeatureValue, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *GameVersionFeatureValueAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.GameVersionFeatureValue, error) {
+	m.calls.record("GameVersionFeatureValueAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("GameVersionFeatureValueAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *GameVersionFeatureValueAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *GameVersionFeatureValueAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *GameVersionFeatureValueAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("GameVersionFeatureValueAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("GameVersionFeatureValueAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *GameVersionFeatureValueAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *GameVersionFeatureValueAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *GameVersionFeatureValueAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("GameVersionFeatureValueAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("GameVersionFeatureValueAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// GameVideoAPI is a mock igdb.GameVideoAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type GameVideoAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.GameVideo, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.GameVideo, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.GameVideo, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.GameVideoAPI = (*GameVideoAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *GameVideoAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *GameVideoAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *GameVideoAPI) Get(id int, opts ...igdb.Option) (*igdb.GameVideo, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *GameVideoAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.GameVideo, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *GameVideoAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.GameVideo, error) {
+	m.calls.record("GameVideoAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("GameVideoAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *GameVideoAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.GameVideo, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *GameVideoAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.GameVideo, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *GameVideoAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.GameVideo, error) {
+	m.calls.record("GameVideoAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("GameVideoAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *GameVideoAPI) Index(opts ...igdb.Option) ([]*igdb.GameVideo, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *GameVideoAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.GameVideo, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *GameVideoAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.GameVideo, error) {
+	m.calls.record("GameVideoAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("GameVideoAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *GameVideoAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *GameVideoAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *GameVideoAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("GameVideoAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("GameVideoAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *GameVideoAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *GameVideoAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *GameVideoAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("GameVideoAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("GameVideoAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// GenreAPI is a mock igdb.GenreAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type GenreAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.Genre, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.Genre, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.Genre, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.GenreAPI = (*GenreAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *GenreAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *GenreAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *GenreAPI) Get(id int, opts ...igdb.Option) (*igdb.Genre, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *GenreAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.Genre, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *GenreAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.Genre, error) {
+	m.calls.record("GenreAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("GenreAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *GenreAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.Genre, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *GenreAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.Genre, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *GenreAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.Genre, error) {
+	m.calls.record("GenreAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("GenreAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *GenreAPI) Index(opts ...igdb.Option) ([]*igdb.Genre, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *GenreAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.Genre, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *GenreAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.Genre, error) {
+	m.calls.record("GenreAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("GenreAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *GenreAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *GenreAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *GenreAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("GenreAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("GenreAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *GenreAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *GenreAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *GenreAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("GenreAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("GenreAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// InvolvedCompanyAPI is a mock igdb.InvolvedCompanyAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type InvolvedCompanyAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.InvolvedCompany, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.InvolvedCompany, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.InvolvedCompany, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.InvolvedCompanyAPI = (*InvolvedCompanyAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *InvolvedCompanyAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *InvolvedCompanyAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *InvolvedCompanyAPI) Get(id int, opts ...igdb.Option) (*igdb.InvolvedCompany, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *InvolvedCompanyAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.InvolvedCompany, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *InvolvedCompanyAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.InvolvedCompany, error) {
+	m.calls.record("InvolvedCompanyAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("InvolvedCompanyAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *InvolvedCompanyAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.InvolvedCompany, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *InvolvedCompanyAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.InvolvedCompany, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *InvolvedCompanyAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.InvolvedCompany, error) {
+	m.calls.record("InvolvedCompanyAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("InvolvedCompanyAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *InvolvedCompanyAPI) Index(opts ...igdb.Option) ([]*igdb.InvolvedCompany, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *InvolvedCompanyAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.InvolvedCompany, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *InvolvedCompanyAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.InvolvedCompany, error) {
+	m.calls.record("InvolvedCompanyAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("InvolvedCompanyAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *InvolvedCompanyAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *InvolvedCompanyAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *InvolvedCompanyAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("InvolvedCompanyAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("InvolvedCompanyAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *InvolvedCompanyAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *InvolvedCompanyAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *InvolvedCompanyAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("InvolvedCompanyAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("InvolvedCompanyAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// KeywordAPI is a mock igdb.KeywordAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type KeywordAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.Keyword, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.Keyword, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.Keyword, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.KeywordAPI = (*KeywordAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *KeywordAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *KeywordAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *KeywordAPI) Get(id int, opts ...igdb.Option) (*igdb.Keyword, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *KeywordAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.Keyword, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *KeywordAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.Keyword, error) {
+	m.calls.record("KeywordAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("KeywordAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *KeywordAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.Keyword, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *KeywordAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.Keyword, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *KeywordAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.Keyword, error) {
+	m.calls.record("KeywordAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("KeywordAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *KeywordAPI) Index(opts ...igdb.Option) ([]*igdb.Keyword, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *KeywordAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.Keyword, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *KeywordAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.Keyword, error) {
+	m.calls.record("KeywordAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("KeywordAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *KeywordAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *KeywordAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *KeywordAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("KeywordAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("KeywordAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *KeywordAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *KeywordAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *KeywordAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("KeywordAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("KeywordAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// MultiplayerModeAPI is a mock igdb.MultiplayerModeAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type MultiplayerModeAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.MultiplayerMode, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.MultiplayerMode, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.MultiplayerMode, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.MultiplayerModeAPI = (*MultiplayerModeAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *MultiplayerModeAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *MultiplayerModeAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *MultiplayerModeAPI) Get(id int, opts ...igdb.Option) (*igdb.MultiplayerMode, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *MultiplayerModeAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.MultiplayerMode, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *MultiplayerModeAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.MultiplayerMode, error) {
+	m.calls.record("MultiplayerModeAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("MultiplayerModeAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *MultiplayerModeAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.MultiplayerMode, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *MultiplayerModeAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.MultiplayerMode, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *MultiplayerModeAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.MultiplayerMode, error) {
+	m.calls.record("MultiplayerModeAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("MultiplayerModeAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *MultiplayerModeAPI) Index(opts ...igdb.Option) ([]*igdb.MultiplayerMode, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *MultiplayerModeAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.MultiplayerMode, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *MultiplayerModeAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.MultiplayerMode, error) {
+	m.calls.record("MultiplayerModeAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("MultiplayerModeAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *MultiplayerModeAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *MultiplayerModeAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *MultiplayerModeAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("MultiplayerModeAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("MultiplayerModeAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *MultiplayerModeAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *MultiplayerModeAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *MultiplayerModeAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("MultiplayerModeAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("MultiplayerModeAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// PageAPI is a mock igdb.PageAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type PageAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.Page, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.Page, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.Page, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.PageAPI = (*PageAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *PageAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *PageAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *PageAPI) Get(id int, opts ...igdb.Option) (*igdb.Page, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *PageAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.Page, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *PageAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.Page, error) {
+	m.calls.record("PageAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("PageAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *PageAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.Page, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *PageAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.Page, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *PageAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.Page, error) {
+	m.calls.record("PageAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("PageAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *PageAPI) Index(opts ...igdb.Option) ([]*igdb.Page, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *PageAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.Page, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *PageAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.Page, error) {
+	m.calls.record("PageAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("PageAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *PageAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *PageAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *PageAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("PageAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("PageAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *PageAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *PageAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *PageAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("PageAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("PageAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// PageBackgroundAPI is a mock igdb.PageBackgroundAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type PageBackgroundAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.PageBackground, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.PageBackground, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.PageBackground, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.PageBackgroundAPI = (*PageBackgroundAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *PageBackgroundAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *PageBackgroundAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *PageBackgroundAPI) Get(id int, opts ...igdb.Option) (*igdb.PageBackground, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *PageBackgroundAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.PageBackground, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *PageBackgroundAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.PageBackground, error) {
+	m.calls.record("PageBackgroundAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("PageBackgroundAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *PageBackgroundAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.PageBackground, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *PageBackgroundAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.PageBackground, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *PageBackgroundAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.PageBackground, error) {
+	m.calls.record("PageBackgroundAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("PageBackgroundAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *PageBackgroundAPI) Index(opts ...igdb.Option) ([]*igdb.PageBackground, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *PageBackgroundAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.PageBackground, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *PageBackgroundAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.PageBackground, error) {
+	m.calls.record("PageBackgroundAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("PageBackgroundAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *PageBackgroundAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *PageBackgroundAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *PageBackgroundAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("PageBackgroundAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("PageBackgroundAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *PageBackgroundAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *PageBackgroundAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *PageBackgroundAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("PageBackgroundAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("PageBackgroundAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// PageLogoAPI is a mock igdb.PageLogoAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type PageLogoAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.PageLogo, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.PageLogo, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.PageLogo, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.PageLogoAPI = (*PageLogoAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *PageLogoAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *PageLogoAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *PageLogoAPI) Get(id int, opts ...igdb.Option) (*igdb.PageLogo, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *PageLogoAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.PageLogo, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *PageLogoAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.PageLogo, error) {
+	m.calls.record("PageLogoAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("PageLogoAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *PageLogoAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.PageLogo, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *PageLogoAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.PageLogo, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *PageLogoAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.PageLogo, error) {
+	m.calls.record("PageLogoAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("PageLogoAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *PageLogoAPI) Index(opts ...igdb.Option) ([]*igdb.PageLogo, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *PageLogoAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.PageLogo, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *PageLogoAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.PageLogo, error) {
+	m.calls.record("PageLogoAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("PageLogoAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *PageLogoAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *PageLogoAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *PageLogoAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("PageLogoAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("PageLogoAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *PageLogoAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *PageLogoAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *PageLogoAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("PageLogoAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("PageLogoAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// PageWebsiteAPI is a mock igdb.PageWebsiteAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type PageWebsiteAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.PageWebsite, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.PageWebsite, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.PageWebsite, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.PageWebsiteAPI = (*PageWebsiteAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *PageWebsiteAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *PageWebsiteAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *PageWebsiteAPI) Get(id int, opts ...igdb.Option) (*igdb.PageWebsite, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *PageWebsiteAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.PageWebsite, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *PageWebsiteAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.PageWebsite, error) {
+	m.calls.record("PageWebsiteAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("PageWebsiteAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *PageWebsiteAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.PageWebsite, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *PageWebsiteAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.PageWebsite, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *PageWebsiteAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.PageWebsite, error) {
+	m.calls.record("PageWebsiteAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("PageWebsiteAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *PageWebsiteAPI) Index(opts ...igdb.Option) ([]*igdb.PageWebsite, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *PageWebsiteAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.PageWebsite, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *PageWebsiteAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.PageWebsite, error) {
+	m.calls.record("PageWebsiteAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("PageWebsiteAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *PageWebsiteAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *PageWebsiteAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *PageWebsiteAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("PageWebsiteAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("PageWebsiteAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *PageWebsiteAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *PageWebsiteAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *PageWebsiteAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("PageWebsiteAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("PageWebsiteAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// PlatformAPI is a mock igdb.PlatformAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type PlatformAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.Platform, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.Platform, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.Platform, error)
+	SearchFunc func(ctx context.Context, qry string, opts ...igdb.Option) ([]*igdb.Platform, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.PlatformAPI = (*PlatformAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *PlatformAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *PlatformAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *PlatformAPI) Get(id int, opts ...igdb.Option) (*igdb.Platform, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *PlatformAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.Platform, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *PlatformAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.Platform, error) {
+	m.calls.record("PlatformAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("PlatformAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *PlatformAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.Platform, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *PlatformAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.Platform, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *PlatformAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.Platform, error) {
+	m.calls.record("PlatformAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("PlatformAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *PlatformAPI) Index(opts ...igdb.Option) ([]*igdb.Platform, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *PlatformAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.Platform, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *PlatformAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.Platform, error) {
+	m.calls.record("PlatformAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("PlatformAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Search records the call and returns the response of SearchFunc.
+func (m *PlatformAPI) Search(qry string, opts ...igdb.Option) ([]*igdb.Platform, error) {
+	return m.search(context.Background(), "Search", qry, opts...)
+}
+
+// SearchContext records the call and returns the response of SearchFunc.
+func (m *PlatformAPI) SearchContext(ctx context.Context, qry string, opts ...igdb.Option) ([]*igdb.Platform, error) {
+	return m.search(ctx, "SearchContext", qry, opts...)
+}
+
+func (m *PlatformAPI) search(ctx context.Context, method string, qry string, opts ...igdb.Option) ([]*igdb.Platform, error) {
+	m.calls.record("PlatformAPI", method, opts, qry)
+	if m.SearchFunc == nil {
+		return nil, notScripted("PlatformAPI", method)
+	}
+
+	return m.SearchFunc(ctx, qry, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *PlatformAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *PlatformAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *PlatformAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("PlatformAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("PlatformAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *PlatformAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *PlatformAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *PlatformAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("PlatformAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("PlatformAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// PlatformLogoAPI is a mock igdb.PlatformLogoAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type PlatformLogoAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.PlatformLogo, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.PlatformLogo, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.PlatformLogo, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.PlatformLogoAPI = (*PlatformLogoAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *PlatformLogoAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *PlatformLogoAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *PlatformLogoAPI) Get(id int, opts ...igdb.Option) (*igdb.PlatformLogo, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *PlatformLogoAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.PlatformLogo, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *PlatformLogoAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.PlatformLogo, error) {
+	m.calls.record("PlatformLogoAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("PlatformLogoAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *PlatformLogoAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.PlatformLogo, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *PlatformLogoAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.PlatformLogo, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *PlatformLogoAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.PlatformLogo, error) {
+	m.calls.record("PlatformLogoAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("PlatformLogoAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *PlatformLogoAPI) Index(opts ...igdb.Option) ([]*igdb.PlatformLogo, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *PlatformLogoAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.PlatformLogo, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *PlatformLogoAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.PlatformLogo, error) {
+	m.calls.record("PlatformLogoAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("PlatformLogoAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *PlatformLogoAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *PlatformLogoAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *PlatformLogoAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("PlatformLogoAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("PlatformLogoAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *PlatformLogoAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *PlatformLogoAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *PlatformLogoAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("PlatformLogoAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("PlatformLogoAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// PlatformVersionAPI is a mock igdb.PlatformVersionAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type PlatformVersionAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.PlatformVersion, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.PlatformVersion, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.PlatformVersion, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.PlatformVersionAPI = (*PlatformVersionAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *PlatformVersionAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *PlatformVersionAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *PlatformVersionAPI) Get(id int, opts ...igdb.Option) (*igdb.PlatformVersion, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *PlatformVersionAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.PlatformVersion, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *PlatformVersionAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.PlatformVersion, error) {
+	m.calls.record("PlatformVersionAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("PlatformVersionAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *PlatformVersionAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.PlatformVersion, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *PlatformVersionAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.PlatformVersion, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *PlatformVersionAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.PlatformVersion, error) {
+	m.calls.record("PlatformVersionAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("PlatformVersionAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *PlatformVersionAPI) Index(opts ...igdb.Option) ([]*igdb.PlatformVersion, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *PlatformVersionAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.PlatformVersion, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *PlatformVersionAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.PlatformVersion, error) {
+	m.calls.record("PlatformVersionAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("PlatformVersionAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *PlatformVersionAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *PlatformVersionAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *PlatformVersionAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("PlatformVersionAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("PlatformVersionAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *PlatformVersionAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *PlatformVersionAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *PlatformVersionAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("PlatformVersionAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("PlatformVersionAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// PlatformVersionCompanyAPI is a mock igdb.PlatformVersionCompanyAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type PlatformVersionCompanyAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.PlatformVersionCompany, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.PlatformVersionCompany, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.PlatformVersionCompany, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.PlatformVersionCompanyAPI = (*PlatformVersionCompanyAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *PlatformVersionCompanyAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *PlatformVersionCompanyAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *PlatformVersionCompanyAPI) Get(id int, opts ...igdb.Option) (*igdb.PlatformVersionCompany, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *PlatformVersionCompanyAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.PlatformVersionCompany, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *PlatformVersionCompanyAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.PlatformVersionCompany, error) {
+	m.calls.record("PlatformVersionCompanyAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("PlatformVersionCompanyAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *PlatformVersionCompanyAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.PlatformVersionCompany, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *PlatformVersionCompanyAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.PlatformVersionCompany, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *PlatformVersionCompanyAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.PlatformVersionCompany, error) {
+	m.calls.record("PlatformVersionCompanyAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("PlatformVersionCompanyAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *PlatformVersionCompanyAPI) Index(opts ...igdb.Option) ([]*igdb.PlatformVersionCompany, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *PlatformVersionCompanyAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.PlatformVersionCompany, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *PlatformVersionCompanyAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.PlatformVersionCompany, error) {
+	m.calls.record("PlatformVersionCompanyAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("PlatformVersionCompanyAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *PlatformVersionCompanyAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *PlatformVersionCompanyAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *PlatformVersionCompanyAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("PlatformVersionCompanyAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("PlatformVersionCompanyAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *PlatformVersionCompanyAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *PlatformVersionCompanyAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *PlatformVersionCompanyAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("PlatformVersionCompanyAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("PlatformVersionCompanyAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// PlatformVersionReleaseDateAPI is a mock igdb.PlatformVersionReleaseDateAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type PlatformVersionReleaseDateAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.PlatformVersionReleaseDate, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.PlatformVersionReleaseDate, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.PlatformVersionReleaseDate, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.PlatformVersionReleaseDateAPI = (*PlatformVersionReleaseDateAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *PlatformVersionReleaseDateAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *PlatformVersionReleaseDateAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *PlatformVersionReleaseDateAPI) Get(id int, opts ...igdb.Option) (*igdb.PlatformVersionReleaseDate, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *PlatformVersionReleaseDateAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.PlatformVersionReleaseDate, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *PlatformVersionReleaseDateAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.PlatformVersionReleaseDate, error) {
+	m.calls.record("PlatformVersionReleaseDateAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("PlatformVersionReleaseDateAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *PlatformVersionReleaseDateAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.PlatformVersionReleaseDate, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *PlatformVersionReleaseDateAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.PlatformVersionReleaseDate, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *PlatformVersionReleaseDateAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.PlatformVersionReleaseDate, error) {
+	m.calls.record("PlatformVersionReleaseDateAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("PlatformVersionReleaseDateAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *PlatformVersionReleaseDateAPI) Index(opts ...igdb.Option) ([]*igdb.PlatformVersionReleaseDate, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *PlatformVersionReleaseDateAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.PlatformVersionReleaseDate, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *PlatformVersionReleaseDateAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.PlatformVersionReleaseDate, error) {
+	m.calls.record("PlatformVersionReleaseDateAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("PlatformVersionReleaseDateAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *PlatformVersionReleaseDateAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *PlatformVersionReleaseDateAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *PlatformVersionReleaseDateAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("PlatformVersionReleaseDateAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("PlatformVersionReleaseDateAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *PlatformVersionReleaseDateAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *PlatformVersionReleaseDateAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *PlatformVersionReleaseDateAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("PlatformVersionReleaseDateAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("PlatformVersionReleaseDateAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// PlatformWebsiteAPI is a mock igdb.PlatformWebsiteAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type PlatformWebsiteAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.PlatformWebsite, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.PlatformWebsite, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.PlatformWebsite, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.PlatformWebsiteAPI = (*PlatformWebsiteAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *PlatformWebsiteAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *PlatformWebsiteAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *PlatformWebsiteAPI) Get(id int, opts ...igdb.Option) (*igdb.PlatformWebsite, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *PlatformWebsiteAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.PlatformWebsite, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *PlatformWebsiteAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.PlatformWebsite, error) {
+	m.calls.record("PlatformWebsiteAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("PlatformWebsiteAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *PlatformWebsiteAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.PlatformWebsite, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *PlatformWebsiteAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.PlatformWebsite, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *PlatformWebsiteAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.PlatformWebsite, error) {
+	m.calls.record("PlatformWebsiteAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("PlatformWebsiteAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *PlatformWebsiteAPI) Index(opts ...igdb.Option) ([]*igdb.PlatformWebsite, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *PlatformWebsiteAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.PlatformWebsite, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *PlatformWebsiteAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.PlatformWebsite, error) {
+	m.calls.record("PlatformWebsiteAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("PlatformWebsiteAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *PlatformWebsiteAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *PlatformWebsiteAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *PlatformWebsiteAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("PlatformWebsiteAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("PlatformWebsiteAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *PlatformWebsiteAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *PlatformWebsiteAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *PlatformWebsiteAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("PlatformWebsiteAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("PlatformWebsiteAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// PlayerPerspectiveAPI is a mock igdb.PlayerPerspectiveAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type PlayerPerspectiveAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.PlayerPerspective, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.PlayerPerspective, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.PlayerPerspective, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.PlayerPerspectiveAPI = (*PlayerPerspectiveAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *PlayerPerspectiveAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *PlayerPerspectiveAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *PlayerPerspectiveAPI) Get(id int, opts ...igdb.Option) (*igdb.PlayerPerspective, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *PlayerPerspectiveAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.PlayerPerspective, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *PlayerPerspectiveAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.PlayerPerspective, error) {
+	m.calls.record("PlayerPerspectiveAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("PlayerPerspectiveAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *PlayerPerspectiveAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.PlayerPerspective, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *PlayerPerspectiveAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.PlayerPerspective, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *PlayerPerspectiveAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.PlayerPerspective, error) {
+	m.calls.record("PlayerPerspectiveAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("PlayerPerspectiveAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *PlayerPerspectiveAPI) Index(opts ...igdb.Option) ([]*igdb.PlayerPerspective, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *PlayerPerspectiveAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.PlayerPerspective, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *PlayerPerspectiveAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.PlayerPerspective, error) {
+	m.calls.record("PlayerPerspectiveAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("PlayerPerspectiveAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *PlayerPerspectiveAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *PlayerPerspectiveAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *PlayerPerspectiveAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("PlayerPerspectiveAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("PlayerPerspectiveAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *PlayerPerspectiveAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *PlayerPerspectiveAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *PlayerPerspectiveAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("PlayerPerspectiveAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("PlayerPerspectiveAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// ProductFamilyAPI is a mock igdb.ProductFamilyAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type ProductFamilyAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.ProductFamily, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.ProductFamily, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.ProductFamily, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.ProductFamilyAPI = (*ProductFamilyAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *ProductFamilyAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *ProductFamilyAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *ProductFamilyAPI) Get(id int, opts ...igdb.Option) (*igdb.ProductFamily, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *ProductFamilyAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.ProductFamily, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *ProductFamilyAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.ProductFamily, error) {
+	m.calls.record("ProductFamilyAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("ProductFamilyAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *ProductFamilyAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.ProductFamily, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *ProductFamilyAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.ProductFamily, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *ProductFamilyAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.ProductFamily, error) {
+	m.calls.record("ProductFamilyAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("ProductFamilyAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *ProductFamilyAPI) Index(opts ...igdb.Option) ([]*igdb.ProductFamily, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *ProductFamilyAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.ProductFamily, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *ProductFamilyAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.ProductFamily, error) {
+	m.calls.record("ProductFamilyAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("ProductFamilyAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *ProductFamilyAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *ProductFamilyAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *ProductFamilyAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("ProductFamilyAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("ProductFamilyAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *ProductFamilyAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *ProductFamilyAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *ProductFamilyAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("ProductFamilyAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("ProductFamilyAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// PulseAPI is a mock igdb.PulseAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type PulseAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.Pulse, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.Pulse, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.Pulse, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.PulseAPI = (*PulseAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *PulseAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *PulseAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *PulseAPI) Get(id int, opts ...igdb.Option) (*igdb.Pulse, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *PulseAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.Pulse, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *PulseAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.Pulse, error) {
+	m.calls.record("PulseAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("PulseAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *PulseAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.Pulse, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *PulseAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.Pulse, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *PulseAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.Pulse, error) {
+	m.calls.record("PulseAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("PulseAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *PulseAPI) Index(opts ...igdb.Option) ([]*igdb.Pulse, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *PulseAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.Pulse, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *PulseAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.Pulse, error) {
+	m.calls.record("PulseAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("PulseAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *PulseAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *PulseAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *PulseAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("PulseAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("PulseAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *PulseAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *PulseAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *PulseAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("PulseAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("PulseAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// PulseGroupAPI is a mock igdb.PulseGroupAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type PulseGroupAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.PulseGroup, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.PulseGroup, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.PulseGroup, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.PulseGroupAPI = (*PulseGroupAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *PulseGroupAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *PulseGroupAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *PulseGroupAPI) Get(id int, opts ...igdb.Option) (*igdb.PulseGroup, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *PulseGroupAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.PulseGroup, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *PulseGroupAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.PulseGroup, error) {
+	m.calls.record("PulseGroupAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("PulseGroupAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *PulseGroupAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.PulseGroup, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *PulseGroupAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.PulseGroup, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *PulseGroupAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.PulseGroup, error) {
+	m.calls.record("PulseGroupAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("PulseGroupAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *PulseGroupAPI) Index(opts ...igdb.Option) ([]*igdb.PulseGroup, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *PulseGroupAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.PulseGroup, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *PulseGroupAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.PulseGroup, error) {
+	m.calls.record("PulseGroupAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("PulseGroupAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *PulseGroupAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *PulseGroupAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *PulseGroupAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("PulseGroupAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("PulseGroupAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *PulseGroupAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *PulseGroupAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *PulseGroupAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("PulseGroupAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("PulseGroupAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// PulseSourceAPI is a mock igdb.PulseSourceAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type PulseSourceAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.PulseSource, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.PulseSource, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.PulseSource, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.PulseSourceAPI = (*PulseSourceAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *PulseSourceAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *PulseSourceAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *PulseSourceAPI) Get(id int, opts ...igdb.Option) (*igdb.PulseSource, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *PulseSourceAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.PulseSource, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *PulseSourceAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.PulseSource, error) {
+	m.calls.record("PulseSourceAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("PulseSourceAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *PulseSourceAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.PulseSource, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *PulseSourceAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.PulseSource, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *PulseSourceAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.PulseSource, error) {
+	m.calls.record("PulseSourceAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("PulseSourceAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *PulseSourceAPI) Index(opts ...igdb.Option) ([]*igdb.PulseSource, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *PulseSourceAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.PulseSource, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *PulseSourceAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.PulseSource, error) {
+	m.calls.record("PulseSourceAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("PulseSourceAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *PulseSourceAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *PulseSourceAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *PulseSourceAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("PulseSourceAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("PulseSourceAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *PulseSourceAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *PulseSourceAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *PulseSourceAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("PulseSourceAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("PulseSourceAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// PulseURLAPI is a mock igdb.PulseURLAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type PulseURLAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.PulseURL, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.PulseURL, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.PulseURL, error)
+	SearchFunc func(ctx context.Context, qry string, opts ...igdb.Option) ([]*igdb.PulseURL, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.PulseURLAPI = (*PulseURLAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *PulseURLAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *PulseURLAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *PulseURLAPI) Get(id int, opts ...igdb.Option) (*igdb.PulseURL, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *PulseURLAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.PulseURL, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *PulseURLAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.PulseURL, error) {
+	m.calls.record("PulseURLAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("PulseURLAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *PulseURLAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.PulseURL, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *PulseURLAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.PulseURL, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *PulseURLAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.PulseURL, error) {
+	m.calls.record("PulseURLAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("PulseURLAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *PulseURLAPI) Index(opts ...igdb.Option) ([]*igdb.PulseURL, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *PulseURLAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.PulseURL, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *PulseURLAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.PulseURL, error) {
+	m.calls.record("PulseURLAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("PulseURLAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Search records the call and returns the response of SearchFunc.
+func (m *PulseURLAPI) Search(qry string, opts ...igdb.Option) ([]*igdb.PulseURL, error) {
+	return m.search(context.Background(), "Search", qry, opts...)
+}
+
+// SearchContext records the call and returns the response of SearchFunc.
+func (m *PulseURLAPI) SearchContext(ctx context.Context, qry string, opts ...igdb.Option) ([]*igdb.PulseURL, error) {
+	return m.search(ctx, "SearchContext", qry, opts...)
+}
+
+func (m *PulseURLAPI) search(ctx context.Context, method string, qry string, opts ...igdb.Option) ([]*igdb.PulseURL, error) {
+	m.calls.record("PulseURLAPI", method, opts, qry)
+	if m.SearchFunc == nil {
+		return nil, notScripted("PulseURLAPI", method)
+	}
+
+	return m.SearchFunc(ctx, qry, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *PulseURLAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *PulseURLAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *PulseURLAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("PulseURLAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("PulseURLAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *PulseURLAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *PulseURLAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *PulseURLAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("PulseURLAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("PulseURLAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// ReleaseDateAPI is a mock igdb.ReleaseDateAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type ReleaseDateAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.ReleaseDate, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.ReleaseDate, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.ReleaseDate, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.ReleaseDateAPI = (*ReleaseDateAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *ReleaseDateAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *ReleaseDateAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *ReleaseDateAPI) Get(id int, opts ...igdb.Option) (*igdb.ReleaseDate, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *ReleaseDateAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.ReleaseDate, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *ReleaseDateAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.ReleaseDate, error) {
+	m.calls.record("ReleaseDateAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("ReleaseDateAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *ReleaseDateAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.ReleaseDate, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *ReleaseDateAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.ReleaseDate, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *ReleaseDateAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.ReleaseDate, error) {
+	m.calls.record("ReleaseDateAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("ReleaseDateAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *ReleaseDateAPI) Index(opts ...igdb.Option) ([]*igdb.ReleaseDate, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *ReleaseDateAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.ReleaseDate, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *ReleaseDateAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.ReleaseDate, error) {
+	m.calls.record("ReleaseDateAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("ReleaseDateAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *ReleaseDateAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *ReleaseDateAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *ReleaseDateAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("ReleaseDateAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("ReleaseDateAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *ReleaseDateAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *ReleaseDateAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *ReleaseDateAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("ReleaseDateAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("ReleaseDateAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// ScreenshotAPI is a mock igdb.ScreenshotAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type ScreenshotAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.Screenshot, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.Screenshot, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.Screenshot, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.ScreenshotAPI = (*ScreenshotAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *ScreenshotAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *ScreenshotAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *ScreenshotAPI) Get(id int, opts ...igdb.Option) (*igdb.Screenshot, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *ScreenshotAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.Screenshot, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *ScreenshotAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.Screenshot, error) {
+	m.calls.record("ScreenshotAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("ScreenshotAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *ScreenshotAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.Screenshot, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *ScreenshotAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.Screenshot, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *ScreenshotAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.Screenshot, error) {
+	m.calls.record("ScreenshotAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("ScreenshotAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *ScreenshotAPI) Index(opts ...igdb.Option) ([]*igdb.Screenshot, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *ScreenshotAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.Screenshot, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *ScreenshotAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.Screenshot, error) {
+	m.calls.record("ScreenshotAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("ScreenshotAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *ScreenshotAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *ScreenshotAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *ScreenshotAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("ScreenshotAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("ScreenshotAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *ScreenshotAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *ScreenshotAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *ScreenshotAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("ScreenshotAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("ScreenshotAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// ThemeAPI is a mock igdb.ThemeAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type ThemeAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.Theme, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.Theme, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.Theme, error)
+	SearchFunc func(ctx context.Context, qry string, opts ...igdb.Option) ([]*igdb.Theme, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.ThemeAPI = (*ThemeAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *ThemeAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *ThemeAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *ThemeAPI) Get(id int, opts ...igdb.Option) (*igdb.Theme, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *ThemeAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.Theme, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *ThemeAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.Theme, error) {
+	m.calls.record("ThemeAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("ThemeAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *ThemeAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.Theme, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *ThemeAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.Theme, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *ThemeAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.Theme, error) {
+	m.calls.record("ThemeAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("ThemeAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *ThemeAPI) Index(opts ...igdb.Option) ([]*igdb.Theme, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *ThemeAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.Theme, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *ThemeAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.Theme, error) {
+	m.calls.record("ThemeAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("ThemeAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Search records the call and returns the response of SearchFunc.
+func (m *ThemeAPI) Search(qry string, opts ...igdb.Option) ([]*igdb.Theme, error) {
+	return m.search(context.Background(), "Search", qry, opts...)
+}
+
+// SearchContext records the call and returns the response of SearchFunc.
+func (m *ThemeAPI) SearchContext(ctx context.Context, qry string, opts ...igdb.Option) ([]*igdb.Theme, error) {
+	return m.search(ctx, "SearchContext", qry, opts...)
+}
+
+func (m *ThemeAPI) search(ctx context.Context, method string, qry string, opts ...igdb.Option) ([]*igdb.Theme, error) {
+	m.calls.record("ThemeAPI", method, opts, qry)
+	if m.SearchFunc == nil {
+		return nil, notScripted("ThemeAPI", method)
+	}
+
+	return m.SearchFunc(ctx, qry, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *ThemeAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *ThemeAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *ThemeAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("ThemeAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("ThemeAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *ThemeAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *ThemeAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *ThemeAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("ThemeAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("ThemeAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// TimeToBeatAPI is a mock igdb.TimeToBeatAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type TimeToBeatAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.TimeToBeat, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.TimeToBeat, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.TimeToBeat, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.TimeToBeatAPI = (*TimeToBeatAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *TimeToBeatAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *TimeToBeatAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *TimeToBeatAPI) Get(id int, opts ...igdb.Option) (*igdb.TimeToBeat, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *TimeToBeatAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.TimeToBeat, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *TimeToBeatAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.TimeToBeat, error) {
+	m.calls.record("TimeToBeatAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("TimeToBeatAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *TimeToBeatAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.TimeToBeat, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *TimeToBeatAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.TimeToBeat, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *TimeToBeatAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.TimeToBeat, error) {
+	m.calls.record("TimeToBeatAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("TimeToBeatAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *TimeToBeatAPI) Index(opts ...igdb.Option) ([]*igdb.TimeToBeat, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *TimeToBeatAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.TimeToBeat, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *TimeToBeatAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.TimeToBeat, error) {
+	m.calls.record("TimeToBeatAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("TimeToBeatAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *TimeToBeatAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *TimeToBeatAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *TimeToBeatAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("TimeToBeatAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("TimeToBeatAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *TimeToBeatAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *TimeToBeatAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *TimeToBeatAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("TimeToBeatAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("TimeToBeatAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// TitleAPI is a mock igdb.TitleAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type TitleAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.Title, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.Title, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.Title, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.TitleAPI = (*TitleAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *TitleAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *TitleAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *TitleAPI) Get(id int, opts ...igdb.Option) (*igdb.Title, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *TitleAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.Title, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *TitleAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.Title, error) {
+	m.calls.record("TitleAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("TitleAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *TitleAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.Title, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *TitleAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.Title, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *TitleAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.Title, error) {
+	m.calls.record("TitleAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("TitleAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *TitleAPI) Index(opts ...igdb.Option) ([]*igdb.Title, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *TitleAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.Title, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *TitleAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.Title, error) {
+	m.calls.record("TitleAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("TitleAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *TitleAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *TitleAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *TitleAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("TitleAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("TitleAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *TitleAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *TitleAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *TitleAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("TitleAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("TitleAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// WebsiteAPI is a mock igdb.WebsiteAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type WebsiteAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.Website, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.Website, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.Website, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.WebsiteAPI = (*WebsiteAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *WebsiteAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *WebsiteAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *WebsiteAPI) Get(id int, opts ...igdb.Option) (*igdb.Website, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *WebsiteAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.Website, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *WebsiteAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.Website, error) {
+	m.calls.record("WebsiteAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("WebsiteAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *WebsiteAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.Website, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *WebsiteAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.Website, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *WebsiteAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.Website, error) {
+	m.calls.record("WebsiteAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("WebsiteAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *WebsiteAPI) Index(opts ...igdb.Option) ([]*igdb.Website, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *WebsiteAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.Website, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *WebsiteAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.Website, error) {
+	m.calls.record("WebsiteAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("WebsiteAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *WebsiteAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *WebsiteAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *WebsiteAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("WebsiteAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("WebsiteAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *WebsiteAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *WebsiteAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *WebsiteAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("WebsiteAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("WebsiteAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// CreditAPI is a mock igdb.CreditAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type CreditAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.Credit, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.Credit, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.Credit, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.CreditAPI = (*CreditAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *CreditAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *CreditAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *CreditAPI) Get(id int, opts ...igdb.Option) (*igdb.Credit, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *CreditAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.Credit, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *CreditAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.Credit, error) {
+	m.calls.record("CreditAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("CreditAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *CreditAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.Credit, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *CreditAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.Credit, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *CreditAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.Credit, error) {
+	m.calls.record("CreditAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("CreditAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *CreditAPI) Index(opts ...igdb.Option) ([]*igdb.Credit, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *CreditAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.Credit, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *CreditAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.Credit, error) {
+	m.calls.record("CreditAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("CreditAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *CreditAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *CreditAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *CreditAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("CreditAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("CreditAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *CreditAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *CreditAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *CreditAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("CreditAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("CreditAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// FeedFollowAPI is a mock igdb.FeedFollowAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type FeedFollowAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.FeedFollow, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.FeedFollow, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.FeedFollow, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.FeedFollowAPI = (*FeedFollowAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *FeedFollowAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *FeedFollowAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *FeedFollowAPI) Get(id int, opts ...igdb.Option) (*igdb.FeedFollow, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *FeedFollowAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.FeedFollow, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *FeedFollowAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.FeedFollow, error) {
+	m.calls.record("FeedFollowAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("FeedFollowAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *FeedFollowAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.FeedFollow, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *FeedFollowAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.FeedFollow, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *FeedFollowAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.FeedFollow, error) {
+	m.calls.record("FeedFollowAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("FeedFollowAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *FeedFollowAPI) Index(opts ...igdb.Option) ([]*igdb.FeedFollow, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *FeedFollowAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.FeedFollow, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *FeedFollowAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.FeedFollow, error) {
+	m.calls.record("FeedFollowAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("FeedFollowAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *FeedFollowAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *FeedFollowAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *FeedFollowAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("FeedFollowAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("FeedFollowAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *FeedFollowAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *FeedFollowAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *FeedFollowAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("FeedFollowAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("FeedFollowAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// FollowAPI is a mock igdb.FollowAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type FollowAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.Follow, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.Follow, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.Follow, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.FollowAPI = (*FollowAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *FollowAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *FollowAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *FollowAPI) Get(id int, opts ...igdb.Option) (*igdb.Follow, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *FollowAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.Follow, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *FollowAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.Follow, error) {
+	m.calls.record("FollowAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("FollowAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *FollowAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.Follow, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *FollowAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.Follow, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *FollowAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.Follow, error) {
+	m.calls.record("FollowAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("FollowAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *FollowAPI) Index(opts ...igdb.Option) ([]*igdb.Follow, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *FollowAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.Follow, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *FollowAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.Follow, error) {
+	m.calls.record("FollowAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("FollowAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *FollowAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *FollowAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *FollowAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("FollowAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("FollowAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *FollowAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *FollowAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *FollowAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("FollowAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("FollowAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// ListAPI is a mock igdb.ListAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type ListAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.List, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.List, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.List, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.ListAPI = (*ListAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *ListAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *ListAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *ListAPI) Get(id int, opts ...igdb.Option) (*igdb.List, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *ListAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.List, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *ListAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.List, error) {
+	m.calls.record("ListAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("ListAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *ListAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.List, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *ListAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.List, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *ListAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.List, error) {
+	m.calls.record("ListAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("ListAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *ListAPI) Index(opts ...igdb.Option) ([]*igdb.List, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *ListAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.List, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *ListAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.List, error) {
+	m.calls.record("ListAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("ListAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *ListAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *ListAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *ListAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("ListAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("ListAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *ListAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *ListAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *ListAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("ListAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("ListAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// ListEntryAPI is a mock igdb.ListEntryAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type ListEntryAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.ListEntry, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.ListEntry, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.ListEntry, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.ListEntryAPI = (*ListEntryAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *ListEntryAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *ListEntryAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *ListEntryAPI) Get(id int, opts ...igdb.Option) (*igdb.ListEntry, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *ListEntryAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.ListEntry, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *ListEntryAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.ListEntry, error) {
+	m.calls.record("ListEntryAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("ListEntryAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *ListEntryAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.ListEntry, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *ListEntryAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.ListEntry, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *ListEntryAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.ListEntry, error) {
+	m.calls.record("ListEntryAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("ListEntryAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *ListEntryAPI) Index(opts ...igdb.Option) ([]*igdb.ListEntry, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *ListEntryAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.ListEntry, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *ListEntryAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.ListEntry, error) {
+	m.calls.record("ListEntryAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("ListEntryAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *ListEntryAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *ListEntryAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *ListEntryAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("ListEntryAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("ListEntryAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *ListEntryAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *ListEntryAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *ListEntryAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("ListEntryAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("ListEntryAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// PersonAPI is a mock igdb.PersonAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type PersonAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.Person, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.Person, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.Person, error)
+	SearchFunc func(ctx context.Context, qry string, opts ...igdb.Option) ([]*igdb.Person, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.PersonAPI = (*PersonAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *PersonAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *PersonAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *PersonAPI) Get(id int, opts ...igdb.Option) (*igdb.Person, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *PersonAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.Person, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *PersonAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.Person, error) {
+	m.calls.record("PersonAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("PersonAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *PersonAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.Person, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *PersonAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.Person, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *PersonAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.Person, error) {
+	m.calls.record("PersonAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("PersonAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *PersonAPI) Index(opts ...igdb.Option) ([]*igdb.Person, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *PersonAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.Person, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *PersonAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.Person, error) {
+	m.calls.record("PersonAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("PersonAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Search records the call and returns the response of SearchFunc.
+func (m *PersonAPI) Search(qry string, opts ...igdb.Option) ([]*igdb.Person, error) {
+	return m.search(context.Background(), "Search", qry, opts...)
+}
+
+// SearchContext records the call and returns the response of SearchFunc.
+func (m *PersonAPI) SearchContext(ctx context.Context, qry string, opts ...igdb.Option) ([]*igdb.Person, error) {
+	return m.search(ctx, "SearchContext", qry, opts...)
+}
+
+func (m *PersonAPI) search(ctx context.Context, method string, qry string, opts ...igdb.Option) ([]*igdb.Person, error) {
+	m.calls.record("PersonAPI", method, opts, qry)
+	if m.SearchFunc == nil {
+		return nil, notScripted("PersonAPI", method)
+	}
+
+	return m.SearchFunc(ctx, qry, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *PersonAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *PersonAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *PersonAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("PersonAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("PersonAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *PersonAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *PersonAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *PersonAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("PersonAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("PersonAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// PersonMugshotAPI is a mock igdb.PersonMugshotAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type PersonMugshotAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.PersonMugshot, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.PersonMugshot, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.PersonMugshot, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.PersonMugshotAPI = (*PersonMugshotAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *PersonMugshotAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *PersonMugshotAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *PersonMugshotAPI) Get(id int, opts ...igdb.Option) (*igdb.PersonMugshot, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *PersonMugshotAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.PersonMugshot, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *PersonMugshotAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.PersonMugshot, error) {
+	m.calls.record("PersonMugshotAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("PersonMugshotAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *PersonMugshotAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.PersonMugshot, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *PersonMugshotAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.PersonMugshot, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *PersonMugshotAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.PersonMugshot, error) {
+	m.calls.record("PersonMugshotAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("PersonMugshotAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *PersonMugshotAPI) Index(opts ...igdb.Option) ([]*igdb.PersonMugshot, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *PersonMugshotAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.PersonMugshot, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *PersonMugshotAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.PersonMugshot, error) {
+	m.calls.record("PersonMugshotAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("PersonMugshotAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *PersonMugshotAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *PersonMugshotAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *PersonMugshotAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("PersonMugshotAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("PersonMugshotAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *PersonMugshotAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *PersonMugshotAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *PersonMugshotAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("PersonMugshotAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("PersonMugshotAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// PersonWebsiteAPI is a mock igdb.PersonWebsiteAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type PersonWebsiteAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.PersonWebsite, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.PersonWebsite, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.PersonWebsite, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.PersonWebsiteAPI = (*PersonWebsiteAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *PersonWebsiteAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *PersonWebsiteAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *PersonWebsiteAPI) Get(id int, opts ...igdb.Option) (*igdb.PersonWebsite, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *PersonWebsiteAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.PersonWebsite, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *PersonWebsiteAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.PersonWebsite, error) {
+	m.calls.record("PersonWebsiteAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("PersonWebsiteAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *PersonWebsiteAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.PersonWebsite, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *PersonWebsiteAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.PersonWebsite, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *PersonWebsiteAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.PersonWebsite, error) {
+	m.calls.record("PersonWebsiteAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("PersonWebsiteAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *PersonWebsiteAPI) Index(opts ...igdb.Option) ([]*igdb.PersonWebsite, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *PersonWebsiteAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.PersonWebsite, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *PersonWebsiteAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.PersonWebsite, error) {
+	m.calls.record("PersonWebsiteAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("PersonWebsiteAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *PersonWebsiteAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *PersonWebsiteAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *PersonWebsiteAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("PersonWebsiteAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("PersonWebsiteAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *PersonWebsiteAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *PersonWebsiteAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *PersonWebsiteAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("PersonWebsiteAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("PersonWebsiteAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// RateAPI is a mock igdb.RateAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type RateAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.Rate, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.Rate, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.Rate, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.RateAPI = (*RateAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *RateAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *RateAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *RateAPI) Get(id int, opts ...igdb.Option) (*igdb.Rate, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *RateAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.Rate, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *RateAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.Rate, error) {
+	m.calls.record("RateAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("RateAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *RateAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.Rate, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *RateAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.Rate, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *RateAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.Rate, error) {
+	m.calls.record("RateAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("RateAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *RateAPI) Index(opts ...igdb.Option) ([]*igdb.Rate, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *RateAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.Rate, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *RateAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.Rate, error) {
+	m.calls.record("RateAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("RateAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *RateAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *RateAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *RateAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("RateAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("RateAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *RateAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *RateAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *RateAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("RateAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("RateAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// ReviewAPI is a mock igdb.ReviewAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type ReviewAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.Review, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.Review, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.Review, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.ReviewAPI = (*ReviewAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *ReviewAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *ReviewAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *ReviewAPI) Get(id int, opts ...igdb.Option) (*igdb.Review, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *ReviewAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.Review, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *ReviewAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.Review, error) {
+	m.calls.record("ReviewAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("ReviewAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *ReviewAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.Review, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *ReviewAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.Review, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *ReviewAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.Review, error) {
+	m.calls.record("ReviewAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("ReviewAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *ReviewAPI) Index(opts ...igdb.Option) ([]*igdb.Review, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *ReviewAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.Review, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *ReviewAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.Review, error) {
+	m.calls.record("ReviewAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("ReviewAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *ReviewAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *ReviewAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *ReviewAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("ReviewAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("ReviewAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *ReviewAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *ReviewAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *ReviewAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("ReviewAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("ReviewAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// ReviewVideoAPI is a mock igdb.ReviewVideoAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type ReviewVideoAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.ReviewVideo, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.ReviewVideo, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.ReviewVideo, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.ReviewVideoAPI = (*ReviewVideoAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *ReviewVideoAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *ReviewVideoAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *ReviewVideoAPI) Get(id int, opts ...igdb.Option) (*igdb.ReviewVideo, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *ReviewVideoAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.ReviewVideo, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *ReviewVideoAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.ReviewVideo, error) {
+	m.calls.record("ReviewVideoAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("ReviewVideoAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *ReviewVideoAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.ReviewVideo, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *ReviewVideoAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.ReviewVideo, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *ReviewVideoAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.ReviewVideo, error) {
+	m.calls.record("ReviewVideoAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("ReviewVideoAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *ReviewVideoAPI) Index(opts ...igdb.Option) ([]*igdb.ReviewVideo, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *ReviewVideoAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.ReviewVideo, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *ReviewVideoAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.ReviewVideo, error) {
+	m.calls.record("ReviewVideoAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("ReviewVideoAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *ReviewVideoAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *ReviewVideoAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *ReviewVideoAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("ReviewVideoAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("ReviewVideoAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *ReviewVideoAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *ReviewVideoAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *ReviewVideoAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("ReviewVideoAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("ReviewVideoAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// SocialMetricAPI is a mock igdb.SocialMetricAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type SocialMetricAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.SocialMetric, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.SocialMetric, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.SocialMetric, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.SocialMetricAPI = (*SocialMetricAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *SocialMetricAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *SocialMetricAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *SocialMetricAPI) Get(id int, opts ...igdb.Option) (*igdb.SocialMetric, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *SocialMetricAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.SocialMetric, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *SocialMetricAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.SocialMetric, error) {
+	m.calls.record("SocialMetricAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("SocialMetricAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *SocialMetricAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.SocialMetric, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *SocialMetricAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.SocialMetric, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *SocialMetricAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.SocialMetric, error) {
+	m.calls.record("SocialMetricAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("SocialMetricAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *SocialMetricAPI) Index(opts ...igdb.Option) ([]*igdb.SocialMetric, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *SocialMetricAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.SocialMetric, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *SocialMetricAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.SocialMetric, error) {
+	m.calls.record("SocialMetricAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("SocialMetricAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *SocialMetricAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *SocialMetricAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *SocialMetricAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("SocialMetricAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("SocialMetricAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *SocialMetricAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *SocialMetricAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *SocialMetricAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("SocialMetricAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("SocialMetricAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+
+// TestDummyAPI is a mock igdb.TestDummyAPI. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type TestDummyAPI struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.TestDummy, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.TestDummy, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.TestDummy, error)
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.TestDummyAPI = (*TestDummyAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *TestDummyAPI) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *TestDummyAPI) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *TestDummyAPI) Get(id int, opts ...igdb.Option) (*igdb.TestDummy, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *TestDummyAPI) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.TestDummy, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *TestDummyAPI) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.TestDummy, error) {
+	m.calls.record("TestDummyAPI", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("TestDummyAPI", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *TestDummyAPI) List(ids []int, opts ...igdb.Option) ([]*igdb.TestDummy, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *TestDummyAPI) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.TestDummy, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *TestDummyAPI) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.TestDummy, error) {
+	m.calls.record("TestDummyAPI", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("TestDummyAPI", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *TestDummyAPI) Index(opts ...igdb.Option) ([]*igdb.TestDummy, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *TestDummyAPI) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.TestDummy, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *TestDummyAPI) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.TestDummy, error) {
+	m.calls.record("TestDummyAPI", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("TestDummyAPI", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+
+// Count records the call and returns the response of CountFunc.
+func (m *TestDummyAPI) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *TestDummyAPI) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *TestDummyAPI) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("TestDummyAPI", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("TestDummyAPI", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *TestDummyAPI) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *TestDummyAPI) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *TestDummyAPI) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("TestDummyAPI", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("TestDummyAPI", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
@@ -0,0 +1,46 @@
+package igdbtest_test
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/Henry-Sarabia/igdb"
+	"github.com/Henry-Sarabia/igdb/igdbtest"
+)
+
+func ExampleServer() {
+	s := igdbtest.NewServer()
+	defer s.Close()
+
+	s.LoadJSON("games", []byte(`[
+		{"id": 1, "name": "Halo", "rating": 92.5},
+		{"id": 2, "name": "Halo 2", "rating": 90.1},
+		{"id": 3, "name": "Zelda", "rating": 97.4}
+	]`))
+
+	c := s.Client()
+
+	games, err := c.Games.Index(
+		igdb.SetFields("name"),
+		igdb.SetWhere(igdb.HasPrefix("name", "halo")),
+		igdb.SetOrder("rating", igdb.OrderAscending),
+	)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	for _, g := range games {
+		fmt.Println(g.Name)
+	}
+
+	s.Inject("games", igdbtest.Fault{Status: http.StatusServiceUnavailable, Times: 1})
+
+	_, err = c.Games.Index()
+	fmt.Println(err != nil)
+
+	// Output:
+	// Halo 2
+	// Halo
+	// true
+}
@@ -0,0 +1,334 @@
+package igdbtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Limits the IGDB places on a single query.
+const (
+	defaultLimit = 10
+	maxLimit     = 500
+)
+
+// record is a single object served by the Server, as decoded from its fixture.
+type record map[string]interface{}
+
+// query is a parsed Apicalypse query.
+type query struct {
+	fields    []string
+	exclude   []string
+	search    string
+	hasSearch bool
+	where     expr
+	sort      string
+	desc      bool
+	limit     int
+	offset    int
+}
+
+// parseQuery parses the provided Apicalypse query. Every statement is
+// terminated by a semicolon and may be abbreviated to its first letter,
+// except for search.
+func parseQuery(body string) (*query, error) {
+	q := &query{limit: defaultLimit}
+
+	stmts, err := splitStatements(body)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, stmt := range stmts {
+		kw, arg := stmt, ""
+		if i := strings.IndexAny(stmt, " \t\r\n"); i >= 0 {
+			kw, arg = stmt[:i], strings.TrimSpace(stmt[i+1:])
+		}
+
+		switch strings.ToLower(kw) {
+		case "fields", "f":
+			q.fields = splitList(arg)
+		case "exclude", "x":
+			q.exclude = splitList(arg)
+		case "search":
+			s, err := unquote(arg)
+			if err != nil {
+				return nil, err
+			}
+			q.search, q.hasSearch = s, true
+		case "where", "w":
+			e, err := parseWhere(arg)
+			if err != nil {
+				return nil, err
+			}
+			if q.where != nil {
+				e = andExpr{q.where, e}
+			}
+			q.where = e
+		case "sort", "s":
+			parts := strings.Fields(arg)
+			if len(parts) < 1 || len(parts) > 2 {
+				return nil, fmt.Errorf("invalid sort %q", arg)
+			}
+			q.sort = parts[0]
+			if len(parts) == 2 {
+				switch strings.ToLower(parts[1]) {
+				case "asc":
+				case "desc":
+					q.desc = true
+				default:
+					return nil, fmt.Errorf("invalid sort order %q", parts[1])
+				}
+			}
+		case "limit", "l":
+			n, err := strconv.Atoi(arg)
+			if err != nil || n < 1 || n > maxLimit {
+				return nil, fmt.Errorf("limit must be a number between 1 and %d, got %q", maxLimit, arg)
+			}
+			q.limit = n
+		case "offset", "o":
+			n, err := strconv.Atoi(arg)
+			if err != nil || n < 0 {
+				return nil, fmt.Errorf("offset must be a positive number, got %q", arg)
+			}
+			q.offset = n
+		default:
+			return nil, fmt.Errorf("unknown statement %q", kw)
+		}
+	}
+
+	return q, nil
+}
+
+// splitStatements splits the provided query into its statements, ignoring
+// semicolons within strings and braces.
+func splitStatements(body string) ([]string, error) {
+	var stmts []string
+	var quoted, escaped bool
+	depth, start := 0, 0
+
+	for i := 0; i < len(body); i++ {
+		c := body[i]
+		switch {
+		case escaped:
+			escaped = false
+		case quoted && c == '\\':
+			escaped = true
+		case c == '"':
+			quoted = !quoted
+		case quoted:
+		case c == '{':
+			depth++
+		case c == '}':
+			depth--
+		case c == ';' && depth == 0:
+			if s := strings.TrimSpace(body[start:i]); s != "" {
+				stmts = append(stmts, s)
+			}
+			start = i + 1
+		}
+	}
+
+	if quoted || depth != 0 {
+		return nil, fmt.Errorf("unterminated string or block")
+	}
+
+	if s := strings.TrimSpace(body[start:]); s != "" {
+		return nil, fmt.Errorf("missing semicolon after %q", s)
+	}
+
+	return stmts, nil
+}
+
+// splitList splits the provided comma separated list, trimming each item.
+func splitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
+
+// unquote returns the contents of the provided quoted string.
+func unquote(s string) (string, error) {
+	p := &parser{s: s}
+	v, err := p.quoted()
+	if err != nil {
+		return "", err
+	}
+
+	if p.skip(); !p.done() {
+		return "", fmt.Errorf("unexpected %q after string", s[p.i:])
+	}
+
+	return v, nil
+}
+
+// filter returns the provided records matching the where
+// and search statements of the query, in order.
+func (q *query) filter(recs []record) []record {
+	var res []record
+	for _, r := range recs {
+		if q.where != nil && !q.where.eval(r) {
+			continue
+		}
+
+		if q.hasSearch {
+			name, _ := r["name"].(string)
+			if !strings.Contains(strings.ToLower(name), strings.ToLower(q.search)) {
+				continue
+			}
+		}
+
+		res = append(res, r)
+	}
+
+	return res
+}
+
+// apply returns the provided records as the query would retrieve them:
+// filtered, sorted, paginated, and stripped to the requested fields.
+func (q *query) apply(recs []record) []record {
+	res := q.filter(recs)
+
+	if q.sort != "" {
+		sort.SliceStable(res, func(i, j int) bool {
+			a, aok := lookup(res[i], q.sort)
+			b, bok := lookup(res[j], q.sort)
+			switch {
+			case !aok || a == nil:
+				return false
+			case !bok || b == nil:
+				return true
+			case q.desc:
+				return less(b, a)
+			}
+			return less(a, b)
+		})
+	}
+
+	if q.offset >= len(res) {
+		return nil
+	}
+	res = res[q.offset:]
+	if len(res) > q.limit {
+		res = res[:q.limit]
+	}
+
+	out := make([]record, len(res))
+	for i, r := range res {
+		out[i] = q.project(r)
+	}
+
+	return out
+}
+
+// project returns a copy of the provided record holding only the requested
+// fields. The ID is always included. Without a fields statement, only the
+// ID is included, as with the IGDB.
+func (q *query) project(r record) record {
+	out := record{}
+
+	for _, f := range q.fields {
+		if f == "*" {
+			for k, v := range r {
+				out[k] = v
+			}
+			continue
+		}
+
+		top := strings.SplitN(f, ".", 2)[0]
+		if v, ok := r[top]; ok {
+			out[top] = v
+		}
+	}
+
+	for _, f := range q.exclude {
+		delete(out, f)
+	}
+
+	if id, ok := r["id"]; ok {
+		out["id"] = id
+	}
+
+	return out
+}
+
+// lookup returns the value of the provided dotted field of the provided
+// record. Fields within arrays of objects are collected into an array.
+func lookup(r record, field string) (interface{}, bool) {
+	var cur interface{} = map[string]interface{}(r)
+
+	for _, part := range strings.Split(field, ".") {
+		switch v := cur.(type) {
+		case map[string]interface{}:
+			next, ok := v[part]
+			if !ok {
+				return nil, false
+			}
+			cur = next
+		case []interface{}:
+			var vals []interface{}
+			for _, el := range v {
+				if m, ok := el.(map[string]interface{}); ok {
+					if next, ok := m[part]; ok {
+						vals = append(vals, next)
+					}
+				}
+			}
+			if len(vals) == 0 {
+				return nil, false
+			}
+			cur = vals
+		default:
+			return nil, false
+		}
+	}
+
+	return cur, true
+}
+
+// number returns the provided value as a float64, if it is a number.
+func number(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	case float64:
+		return n, true
+	}
+
+	return 0, false
+}
+
+// equal returns true if the provided values are equal. Numbers are
+// compared by value regardless of their representation.
+func equal(a, b interface{}) bool {
+	if x, ok := number(a); ok {
+		y, ok := number(b)
+		return ok && x == y
+	}
+
+	return a == b
+}
+
+// less returns true if a sorts before b. Numbers sort before strings.
+func less(a, b interface{}) bool {
+	x, xok := number(a)
+	y, yok := number(b)
+	switch {
+	case xok && yok:
+		return x < y
+	case xok != yok:
+		return xok
+	}
+
+	s, _ := a.(string)
+	t, _ := b.(string)
+	return s < t
+}
@@ -0,0 +1,114 @@
+package igdbtest
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"reflect"
+	"testing"
+)
+
+func TestParseQuery(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		want    query
+		wantErr bool
+	}{
+		{"Empty", "", query{limit: defaultLimit}, false},
+		{
+			"Every statement",
+			`fields name,rating; exclude rating; search "Halo"; sort rating desc; limit 5; offset 10; `,
+			query{fields: []string{"name", "rating"}, exclude: []string{"rating"}, search: "Halo", hasSearch: true, sort: "rating", desc: true, limit: 5, offset: 10},
+			false,
+		},
+		{
+			"Abbreviations",
+			"f *; x summary; s name asc; l 2; o 1;",
+			query{fields: []string{"*"}, exclude: []string{"summary"}, sort: "name", limit: 2, offset: 1},
+			false,
+		},
+		{"Semicolon in string", `search "a;b";`, query{search: "a;b", hasSearch: true, limit: defaultLimit}, false},
+		{"Escaped quote in string", `search "a\"b";`, query{search: `a"b`, hasSearch: true, limit: defaultLimit}, false},
+		{"Missing semicolon", "fields name", query{}, true},
+		{"Unknown statement", "select name;", query{}, true},
+		{"Limit too large", "limit 501;", query{}, true},
+		{"Limit too small", "limit 0;", query{}, true},
+		{"Negative offset", "offset -1;", query{}, true},
+		{"Invalid sort order", "sort name up;", query{}, true},
+		{"Invalid where", "where id;", query{}, true},
+		{"Unquoted search", "search Halo;", query{}, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			q, err := parseQuery(test.body)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("got: <%v>, want error: <%v>", err, test.wantErr)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if !reflect.DeepEqual(*q, test.want) {
+				t.Errorf("got: <%+v>, want: <%+v>", *q, test.want)
+			}
+		})
+	}
+}
+
+func TestQuery_Apply(t *testing.T) {
+	b, err := ioutil.ReadFile("test_data/games.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var recs []record
+	if err := json.Unmarshal(b, &recs); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		body    string
+		wantIDs []float64
+		wantLen int
+	}{
+		{"Default limit", "", []float64{1, 2, 3, 4, 5}, 1},
+		{"Only ID without fields", "limit 1;", []float64{1}, 1},
+		{"Fields", "fields name; limit 1;", []float64{1}, 2},
+		{"Every field", "fields *; limit 1;", []float64{1}, 7},
+		{"Exclude", "fields *; exclude rating, genres; limit 1;", []float64{1}, 5},
+		{"Expanded field keeps top level", "fields parent_game.name; where id = 5;", []float64{5}, 2},
+		{"Where", "where platforms = 11;", []float64{1, 2}, 1},
+		{"Sort ascending", "sort rating asc;", []float64{5, 2, 1, 3, 4}, 1},
+		{"Sort descending", "sort rating desc;", []float64{3, 1, 2, 5, 4}, 1},
+		{"Sort string", "sort name;", []float64{2, 4, 1, 3, 5}, 1},
+		{"Limit", "sort id desc; limit 2;", []float64{5, 4}, 1},
+		{"Offset", "offset 3;", []float64{4, 5}, 1},
+		{"Offset past end", "offset 10;", nil, 0},
+		{"Search", `search "halo";`, []float64{1, 2, 4}, 1},
+		{"Search and where", `search "halo"; where rating != null;`, []float64{1, 2}, 1},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			q, err := parseQuery(test.body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			res := q.apply(recs)
+
+			var ids []float64
+			for _, r := range res {
+				ids = append(ids, r["id"].(float64))
+				if len(r) != test.wantLen {
+					t.Errorf("got: <%v> fields, want: <%v>", len(r), test.wantLen)
+				}
+			}
+
+			if !reflect.DeepEqual(ids, test.wantIDs) {
+				t.Errorf("got: <%v>, want: <%v>", ids, test.wantIDs)
+			}
+		})
+	}
+}
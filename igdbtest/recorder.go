@@ -0,0 +1,337 @@
+package igdbtest
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Mode is the mode of a Recorder.
+type Mode int
+
+// Available modes of a Recorder.
+const (
+	// ModeReplay serves recorded interactions without contacting the network.
+	ModeReplay Mode = iota
+	// ModeRecord sends every request and records the interaction.
+	ModeRecord
+)
+
+// Redacted replaces the value of every redacted header and credential
+// before an interaction is recorded.
+const Redacted = "REDACTED"
+
+// redactedHeaders are the headers redacted by default: the credentials
+// added by the igdb Authenticators and any cookies.
+var redactedHeaders = []string{"Authorization", "Client-ID", "User-Key", "Cookie", "Set-Cookie"}
+
+// redactedParams are the URL query parameters and JSON response fields
+// redacted by default: the credentials of the Twitch token endpoint.
+var redactedParams = []string{"client_id", "client_secret", "access_token"}
+
+// Recorder is an http.RoundTripper that records requests to the IGDB and
+// their responses to fixture files and replays them, so that code using the
+// igdb package can be tested against real responses without the network.
+// Provide the http.Client returned by Client to igdb.NewClient.
+//
+// Every interaction is stored in its own JSON file within the directory of the
+// Recorder, named after its endpoint and keyed by its normalized query: the
+// statements of the query are trimmed, their whitespace is collapsed, and they
+// are sorted. Credentials are redacted before anything is written to disk.
+// A Recorder is safe for concurrent use.
+type Recorder struct {
+	// Transport sends the requests of a Recorder in ModeRecord. If nil,
+	// http.DefaultTransport is used instead.
+	Transport http.RoundTripper
+
+	dir    string
+	mode   Mode
+	redact []string
+	mu     sync.Mutex
+}
+
+// NewRecorder returns a Recorder in the provided mode storing its fixtures
+// in the provided directory, which is created when the first interaction is
+// recorded. Any provided headers are redacted in addition to the
+// Authorization, Client-ID, user-key, and cookie headers.
+func NewRecorder(dir string, mode Mode, redact ...string) *Recorder {
+	return &Recorder{
+		dir:    dir,
+		mode:   mode,
+		redact: append(append([]string(nil), redactedHeaders...), redact...),
+	}
+}
+
+// Client returns an http.Client sending its requests through the Recorder.
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// Interaction is a recorded request and its response, as stored in a fixture.
+type Interaction struct {
+	// Endpoint is the path of the request, without slashes (e.g. "v4/games").
+	Endpoint string `json:"endpoint"`
+	// Method is the method of the request.
+	Method string `json:"method"`
+	// URL is the URL of the request, with any credentials redacted.
+	URL string `json:"url"`
+	// Query is the normalized Apicalypse query in the body of the request.
+	Query string `json:"query"`
+	// RequestHeader is the redacted header of the request.
+	RequestHeader http.Header `json:"request_header,omitempty"`
+	// Status is the status code of the response.
+	Status int `json:"status"`
+	// Header is the redacted header of the response.
+	Header http.Header `json:"header,omitempty"`
+	// Body is the body of the response, if it is valid JSON.
+	Body json.RawMessage `json:"body,omitempty"`
+	// Text is the body of the response, if it is not valid JSON.
+	Text string `json:"text,omitempty"`
+}
+
+// UnmatchedError occurs when a Recorder in ModeReplay receives a request
+// that was never recorded.
+type UnmatchedError struct {
+	Endpoint string
+	Query    string
+	// Path is the fixture the interaction was expected in.
+	Path string
+}
+
+// Error returns the endpoint and query of the unmatched request.
+func (e *UnmatchedError) Error() string {
+	return fmt.Sprintf("igdbtest: no recorded interaction for endpoint %q with query %q (expected %s)", e.Endpoint, e.Query, e.Path)
+}
+
+// RoundTrip replays the recorded response to the provided request or, in
+// ModeRecord, sends the request and records its response.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		b, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		body = b
+	}
+
+	end := endpointName(req.URL.Path)
+	qry := normalizeQuery(string(body))
+	path := r.path(end, qry, redactURL(req.URL.Query()))
+
+	if r.mode == ModeRecord {
+		return r.record(req, body, end, qry, path)
+	}
+
+	b, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, &UnmatchedError{Endpoint: end, Query: qry, Path: path}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var in Interaction
+	if err := json.Unmarshal(b, &in); err != nil {
+		return nil, fmt.Errorf("igdbtest: cannot decode fixture %s: %v", path, err)
+	}
+
+	return in.response(req), nil
+}
+
+// record sends the provided request with the provided body and records
+// the interaction to the provided path.
+func (r *Recorder) record(req *http.Request, body []byte, end, qry, path string) (*http.Response, error) {
+	t := r.Transport
+	if t == nil {
+		t = http.DefaultTransport
+	}
+
+	out := req.Clone(req.Context())
+	out.Body = ioutil.NopCloser(bytes.NewReader(body))
+	out.ContentLength = int64(len(body))
+
+	resp, err := t.RoundTrip(out)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	u := *req.URL
+	u.RawQuery = redactURL(req.URL.Query())
+
+	in := Interaction{
+		Endpoint:      end,
+		Method:        req.Method,
+		URL:           u.String(),
+		Query:         qry,
+		RequestHeader: r.redactHeader(req.Header),
+		Status:        resp.StatusCode,
+		Header:        r.redactHeader(resp.Header),
+	}
+	if json.Valid(b) {
+		in.Body = redactBody(b)
+	} else {
+		in.Text = string(b)
+	}
+
+	if err := r.write(path, &in); err != nil {
+		return nil, err
+	}
+
+	resp.Body = ioutil.NopCloser(bytes.NewReader(b))
+	return resp, nil
+}
+
+// write writes the provided Interaction to the provided path.
+func (r *Recorder) write(path string, in *Interaction) error {
+	b, err := json.MarshalIndent(in, "", "\t")
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, append(b, '\n'), 0644)
+}
+
+// path returns the path of the fixture of the interaction with the
+// provided endpoint, normalized query, and redacted URL query.
+func (r *Recorder) path(end, qry, params string) string {
+	sum := sha256.Sum256([]byte(end + "\n" + qry + "\n" + params))
+	name := strings.Replace(end, "/", "_", -1) + "_" + hex.EncodeToString(sum[:6]) + ".json"
+
+	return filepath.Join(r.dir, name)
+}
+
+// response returns the recorded response to the provided request.
+func (in *Interaction) response(req *http.Request) *http.Response {
+	b := []byte(in.Text)
+	if len(in.Body) > 0 {
+		b = in.Body
+	}
+
+	header := in.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", in.Status, http.StatusText(in.Status)),
+		StatusCode:    in.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader(b)),
+		ContentLength: int64(len(b)),
+		Request:       req,
+	}
+}
+
+// redactHeader returns a copy of the provided header with the
+// redacted headers of the Recorder replaced.
+func (r *Recorder) redactHeader(h http.Header) http.Header {
+	out := h.Clone()
+	for _, k := range r.redact {
+		if _, ok := out[http.CanonicalHeaderKey(k)]; ok {
+			out.Set(k, Redacted)
+		}
+	}
+
+	return out
+}
+
+// redactURL returns the provided URL query with any credentials replaced.
+func redactURL(q map[string][]string) string {
+	vals := make(map[string][]string, len(q))
+	for k, v := range q {
+		vals[k] = v
+	}
+
+	for _, k := range redactedParams {
+		if _, ok := vals[k]; ok {
+			vals[k] = []string{Redacted}
+		}
+	}
+
+	keys := make([]string, 0, len(vals))
+	for k := range vals {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var parts []string
+	for _, k := range keys {
+		for _, v := range vals[k] {
+			parts = append(parts, k+"="+v)
+		}
+	}
+
+	return strings.Join(parts, "&")
+}
+
+// redactBody returns the provided JSON with the credential fields of a
+// top level object replaced. Any other JSON is returned as is.
+func redactBody(b []byte) []byte {
+	var obj map[string]json.RawMessage
+	if json.Unmarshal(b, &obj) != nil {
+		return b
+	}
+
+	var found bool
+	for _, k := range redactedParams {
+		if _, ok := obj[k]; ok {
+			obj[k], _ = json.Marshal(Redacted)
+			found = true
+		}
+	}
+	if !found {
+		return b
+	}
+
+	out, err := json.Marshal(obj)
+	if err != nil {
+		return b
+	}
+
+	return out
+}
+
+// normalizeQuery returns the provided query with its statements trimmed,
+// their whitespace collapsed, and sorted, so that equivalent queries share
+// a fixture. A query that cannot be split into statements only has its
+// whitespace collapsed.
+func normalizeQuery(body string) string {
+	stmts, err := splitStatements(body)
+	if err != nil {
+		return strings.Join(strings.Fields(body), " ")
+	}
+
+	for i, s := range stmts {
+		stmts[i] = strings.Join(strings.Fields(s), " ") + ";"
+	}
+	sort.Strings(stmts)
+
+	return strings.Join(stmts, " ")
+}
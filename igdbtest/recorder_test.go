@@ -0,0 +1,170 @@
+package igdbtest
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Henry-Sarabia/igdb"
+	"github.com/pkg/errors"
+)
+
+func TestNormalizeQuery(t *testing.T) {
+	var tests = []struct {
+		name string
+		qry  string
+		want string
+	}{
+		{"Empty", "", ""},
+		{"Single statement", "fields name;", "fields name;"},
+		{"Whitespace", "  where   id = 1 ;\n\tfields  name ; ", "fields name; where id = 1;"},
+		{"Reordered", "limit 5; fields name; ", "fields name; limit 5;"},
+		{"Unterminated", " fields   name ", "fields name"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := normalizeQuery(test.qry)
+			if got != test.want {
+				t.Errorf("got: <%v>, want: <%v>", got, test.want)
+			}
+		})
+	}
+
+	if normalizeQuery("fields name; limit 5;") != normalizeQuery("limit 5;\nfields  name;") {
+		t.Error("got: different keys, want: equivalent queries sharing a key")
+	}
+}
+
+func TestRecorder_RecordReplay(t *testing.T) {
+	dir, err := ioutil.TempDir("", "igdbtest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	s := newGameServer(t)
+
+	rec := NewRecorder(dir, ModeRecord)
+	rec.Transport = s.ts.Client().Transport
+	c := igdb.NewClient("secret-key", rec.Client(), igdb.WithBaseURL(s.URL))
+
+	opts := []igdb.Option{igdb.SetFields("name"), igdb.SetWhere(igdb.Gt("rating", 90)), igdb.SetOrder("rating", igdb.OrderDescending)}
+	want, err := c.Games.Index(opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.Games.Index(igdb.SetWhere(igdb.Eq("id", 404))); errors.Cause(err) != igdb.ErrNotFound {
+		t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), igdb.ErrNotFound)
+	}
+
+	s.Close()
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 2 {
+		t.Fatalf("got: <%v> fixtures, want: <%v>", len(files), 2)
+	}
+	for _, f := range files {
+		b, err := ioutil.ReadFile(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.Contains(string(b), "secret-key") {
+			t.Errorf("got: <%s>, want fixture without the API key", b)
+		}
+		if !strings.Contains(string(b), Redacted) {
+			t.Errorf("got: <%s>, want fixture with redacted user-key", b)
+		}
+		if !strings.HasPrefix(filepath.Base(f), "games_") {
+			t.Errorf("got: <%v>, want fixture named after its endpoint", filepath.Base(f))
+		}
+	}
+
+	rep := NewRecorder(dir, ModeReplay)
+	c = igdb.NewClient("another-key", rep.Client(), igdb.WithBaseURL(s.URL))
+
+	got, err := c.Games.Index(opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: <%v>, want: <%v>", got, want)
+	}
+
+	if _, err := c.Games.Index(igdb.SetWhere(igdb.Eq("id", 404))); errors.Cause(err) != igdb.ErrNotFound {
+		t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), igdb.ErrNotFound)
+	}
+
+	_, err = c.Games.Index(igdb.SetFields("summary"))
+	var unmatched *UnmatchedError
+	if !errors.As(err, &unmatched) {
+		t.Fatalf("got: <%v>, want: <%T>", err, unmatched)
+	}
+	if unmatched.Endpoint != "games" || unmatched.Query != "fields summary;" {
+		t.Errorf("got: <%v>, want unmatched endpoint and query", unmatched)
+	}
+}
+
+func TestRecorder_RedactToken(t *testing.T) {
+	dir, err := ioutil.TempDir("", "igdbtest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Set-Cookie", "session=secret-cookie")
+		w.Write([]byte(`{"access_token":"secret-token","expires_in":3600,"token_type":"bearer"}`))
+	}))
+
+	rec := NewRecorder(dir, ModeRecord)
+	rec.Transport = ts.Client().Transport
+
+	auth := igdb.NewTwitchAuth("secret-id", "secret-client", rec.Client())
+	auth.TokenURL = ts.URL + "/oauth2/token"
+
+	tok, err := auth.Token(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tok != "secret-token" {
+		t.Errorf("got: <%v>, want: <%v>", tok, "secret-token")
+	}
+
+	ts.Close()
+
+	files, _ := filepath.Glob(filepath.Join(dir, "*.json"))
+	if len(files) != 1 {
+		t.Fatalf("got: <%v> fixtures, want: <%v>", len(files), 1)
+	}
+	b, err := ioutil.ReadFile(files[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"secret-id", "secret-client", "secret-token", "secret-cookie"} {
+		if strings.Contains(string(b), secret) {
+			t.Errorf("got: <%s>, want fixture without <%v>", b, secret)
+		}
+	}
+
+	auth = igdb.NewTwitchAuth("other-id", "other-client", NewRecorder(dir, ModeReplay).Client())
+	auth.TokenURL = ts.URL + "/oauth2/token"
+
+	tok, err = auth.Token(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tok != Redacted {
+		t.Errorf("got: <%v>, want: <%v>", tok, Redacted)
+	}
+}
@@ -0,0 +1,396 @@
+// Package igdbtest provides a fake IGDB server for testing code that uses the
+// igdb package. The Server serves objects loaded from fixtures and runs the
+// Apicalypse queries it receives against them, so that the filters, sorting,
+// and pagination of a query can be tested without contacting the IGDB.
+//
+// The Server supports the fields, exclude, search, where, sort, limit, and
+// offset statements, the count and meta endpoints of every endpoint, and the
+// multiquery endpoint. Expanded fields (e.g. "cover.image_id") are not
+// resolved; the field is served as it appears in the fixture.
+//
+// The Recorder records real requests to the IGDB and their responses to
+// fixture files and replays them without contacting the network.
+package igdbtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Henry-Sarabia/igdb"
+)
+
+// Server is a fake IGDB server. A Server is safe for concurrent use,
+// including loading fixtures and injecting faults while serving requests.
+type Server struct {
+	// URL is the base URL of the Server, to be used with igdb.WithBaseURL.
+	URL string
+
+	ts       *httptest.Server
+	mu       sync.Mutex
+	fixtures map[string][]record
+	faults   []*fault
+	latency  time.Duration
+	requests []Request
+}
+
+// Request is a request received by the Server.
+type Request struct {
+	// Endpoint is the requested endpoint, without slashes (e.g. "games/count").
+	Endpoint string
+	// Query is the Apicalypse query in the body of the request.
+	Query string
+	// Header is the header of the request.
+	Header http.Header
+}
+
+// Fault is an error or a delay injected into the responses of the Server.
+type Fault struct {
+	// Status is the status code of the response. If zero, the request is
+	// served normally after the Latency elapses.
+	Status int
+	// Body is the body of the response. If empty, an error detail
+	// resembling those of the IGDB is used.
+	Body string
+	// Header is added to the header of the response (e.g. Retry-After).
+	Header http.Header
+	// Latency is how long the Server waits before responding.
+	Latency time.Duration
+	// Times is the number of requests the Fault applies to. If zero or
+	// less, the Fault applies to every request until it is cleared.
+	Times int
+}
+
+// fault is a Fault injected into the responses of a single endpoint.
+type fault struct {
+	Fault
+	end string
+}
+
+// NewServer starts and returns a new Server without any fixtures. The
+// Server should be closed once done.
+func NewServer() *Server {
+	s := &Server{fixtures: make(map[string][]record)}
+	s.ts = httptest.NewServer(http.HandlerFunc(s.serve))
+	s.URL = s.ts.URL + "/"
+
+	return s
+}
+
+// Close shuts down the Server and blocks until every request is done.
+func (s *Server) Close() {
+	s.ts.Close()
+}
+
+// Client returns an igdb.Client configured to communicate with the Server.
+// Any provided client options are applied before the base URL is set.
+func (s *Server) Client(opts ...igdb.ClientOption) *igdb.Client {
+	opts = append(opts, igdb.WithBaseURL(s.URL))
+	return igdb.NewClient("igdbtest", s.ts.Client(), opts...)
+}
+
+// endpointName returns the provided endpoint without slashes, accepting
+// both "games" and the form of the igdb endpoint constants, "games/".
+func endpointName(end string) string {
+	return strings.Trim(end, "/")
+}
+
+// Load adds the provided objects to the fixtures of the provided endpoint
+// (e.g. "games" or "private/people"). The objects are either a single object
+// or a slice of objects, such as a []*igdb.Game, and are encoded as JSON
+// before they are stored. Objects are served in the order they are loaded.
+func (s *Server) Load(end string, objs interface{}) error {
+	b, err := json.Marshal(objs)
+	if err != nil {
+		return err
+	}
+
+	return s.LoadJSON(end, b)
+}
+
+// LoadJSON adds the objects in the provided JSON to the fixtures of the
+// provided endpoint. The JSON is either a single object or an array of objects.
+func (s *Server) LoadJSON(end string, b []byte) error {
+	b = bytes.TrimSpace(b)
+	if len(b) > 0 && b[0] == '{' {
+		b = append(append([]byte("["), b...), ']')
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+
+	var recs []record
+	if err := dec.Decode(&recs); err != nil {
+		return fmt.Errorf("igdbtest: cannot load fixtures of %q: %v", end, err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	end = endpointName(end)
+	s.fixtures[end] = append(s.fixtures[end], recs...)
+	return nil
+}
+
+// LoadFile adds the objects in the provided JSON file to the fixtures
+// of the provided endpoint.
+func (s *Server) LoadFile(end, path string) error {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	return s.LoadJSON(end, b)
+}
+
+// LoadDir adds the objects in every JSON file within the provided directory
+// to the fixtures of the endpoint named after the file's path relative to the
+// directory, without its extension. For example, "games.json" is loaded into
+// "games" and "private/people.json" into "private/people".
+func (s *Server) LoadDir(dir string) error {
+	return filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || filepath.Ext(path) != ".json" {
+			return err
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+
+		end := filepath.ToSlash(strings.TrimSuffix(rel, ".json"))
+		return s.LoadFile(end, path)
+	})
+}
+
+// Reset removes every fixture, fault, and recorded request of the Server.
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.fixtures = make(map[string][]record)
+	s.faults = nil
+	s.latency = 0
+	s.requests = nil
+}
+
+// Inject injects the provided Fault into the responses of the provided
+// endpoint, including its count and meta endpoints. An empty endpoint
+// matches every endpoint. When several Faults match a request, the
+// earliest one injected applies.
+func (s *Server) Inject(end string, f Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = append(s.faults, &fault{Fault: f, end: endpointName(end)})
+}
+
+// ClearFaults removes every injected Fault.
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = nil
+}
+
+// SetLatency sets how long the Server waits before every response,
+// in addition to the Latency of any injected Fault.
+func (s *Server) SetLatency(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.latency = d
+}
+
+// Requests returns the requests received by the Server, in order.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]Request(nil), s.requests...)
+}
+
+// fault returns the Fault to apply to a request to the provided
+// endpoint, consuming one of its Times, if any.
+func (s *Server) fault(end string) (Fault, bool) {
+	base := strings.TrimSuffix(strings.TrimSuffix(end, "/count"), "/meta")
+
+	for i, f := range s.faults {
+		if f.end != "" && f.end != base && f.end != end {
+			continue
+		}
+
+		if f.Times > 0 {
+			f.Times--
+			if f.Times == 0 {
+				s.faults = append(s.faults[:i:i], s.faults[i+1:]...)
+			}
+		}
+
+		return f.Fault, true
+	}
+
+	return Fault{}, false
+}
+
+// serve handles a single request to the Server.
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	b, _ := ioutil.ReadAll(r.Body)
+	end := endpointName(r.URL.Path)
+
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{Endpoint: end, Query: string(b), Header: r.Header.Clone()})
+	f, faulty := s.fault(end)
+	latency := s.latency + f.Latency
+	s.mu.Unlock()
+
+	if latency > 0 {
+		select {
+		case <-time.After(latency):
+		case <-r.Context().Done():
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if faulty && f.Status != 0 {
+		for k, vals := range f.Header {
+			for _, v := range vals {
+				w.Header().Add(k, v)
+			}
+		}
+
+		body := f.Body
+		if body == "" {
+			body = detail("Injected Fault", f.Status, http.StatusText(f.Status))
+		}
+
+		w.WriteHeader(f.Status)
+		fmt.Fprint(w, body)
+		return
+	}
+
+	res, err := s.respond(end, string(b))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, detail("Syntax Error", http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	b, _ = json.Marshal(res)
+	w.Write(b)
+}
+
+// detail returns an error detail resembling those of the IGDB.
+func detail(title string, status int, cause string) string {
+	b, _ := json.Marshal([]map[string]interface{}{{"title": title, "status": status, "cause": cause}})
+	return string(b)
+}
+
+// respond returns the response to the provided query against the provided endpoint.
+func (s *Server) respond(end, body string) (interface{}, error) {
+	if end == "multiquery" {
+		return s.multiquery(body)
+	}
+
+	q, err := parseQuery(body)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.run(end, q), nil
+}
+
+// run returns the results of the provided query against the provided
+// endpoint, its count endpoint, or its meta endpoint.
+func (s *Server) run(end string, q *query) interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch {
+	case strings.HasSuffix(end, "/count"):
+		recs := s.fixtures[strings.TrimSuffix(end, "/count")]
+		return map[string]int{"count": len(q.filter(recs))}
+	case strings.HasSuffix(end, "/meta"):
+		set := make(map[string]bool)
+		for _, r := range s.fixtures[strings.TrimSuffix(end, "/meta")] {
+			for k := range r {
+				set[k] = true
+			}
+		}
+		fields := make([]string, 0, len(set))
+		for k := range set {
+			fields = append(fields, k)
+		}
+		sort.Strings(fields)
+		return fields
+	}
+
+	res := q.apply(s.fixtures[end])
+	if res == nil {
+		res = []record{}
+	}
+
+	return res
+}
+
+// multiResult is the result of a single named query of a multiquery.
+type multiResult struct {
+	Name   string      `json:"name"`
+	Result interface{} `json:"result,omitempty"`
+	Count  *int        `json:"count,omitempty"`
+}
+
+// multiquery returns the results of every named query of the provided
+// multiquery, in the form `query games "name" { ... };`.
+func (s *Server) multiquery(body string) (interface{}, error) {
+	stmts, err := splitStatements(body)
+	if err != nil {
+		return nil, err
+	}
+
+	res := []multiResult{}
+	for _, stmt := range stmts {
+		open, close := strings.IndexByte(stmt, '{'), strings.LastIndexByte(stmt, '}')
+		if !strings.HasPrefix(stmt, "query ") || open < 0 || close < open {
+			return nil, fmt.Errorf("invalid multiquery statement %q", stmt)
+		}
+
+		head := strings.TrimSpace(stmt[len("query "):open])
+		i := strings.IndexByte(head, ' ')
+		if i < 0 {
+			return nil, fmt.Errorf("missing query name in %q", stmt)
+		}
+
+		name, err := unquote(strings.TrimSpace(head[i+1:]))
+		if err != nil {
+			return nil, err
+		}
+
+		q, err := parseQuery(stmt[open+1 : close])
+		if err != nil {
+			return nil, err
+		}
+
+		end := endpointName(head[:i])
+		switch r := s.run(end, q).(type) {
+		case map[string]int:
+			ct := r["count"]
+			res = append(res, multiResult{Name: name, Count: &ct})
+		default:
+			res = append(res, multiResult{Name: name, Result: r})
+		}
+	}
+
+	return res, nil
+}
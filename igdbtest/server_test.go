@@ -0,0 +1,281 @@
+package igdbtest
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Henry-Sarabia/igdb"
+	"github.com/pkg/errors"
+)
+
+// newGameServer returns a Server loaded with the fixtures in test_data.
+func newGameServer(t *testing.T) *Server {
+	s := NewServer()
+	if err := s.LoadDir("test_data"); err != nil {
+		s.Close()
+		t.Fatal(err)
+	}
+
+	return s
+}
+
+// gameIDs returns the IDs of the provided Games.
+func gameIDs(games []*igdb.Game) []int {
+	var ids []int
+	for _, g := range games {
+		ids = append(ids, g.ID)
+	}
+
+	return ids
+}
+
+func TestServer_Index(t *testing.T) {
+	s := newGameServer(t)
+	defer s.Close()
+	c := s.Client()
+
+	tests := []struct {
+		name    string
+		opts    []igdb.Option
+		wantIDs []int
+		wantErr error
+	}{
+		{"Default", nil, []int{1, 2, 3, 4, 5}, nil},
+		{"Where", []igdb.Option{igdb.SetWhere(igdb.ContainsAny("platforms", 11, 130))}, []int{1, 2, 3, 5}, nil},
+		{"Filter", []igdb.Option{igdb.SetFilter("rating", igdb.OpGreaterThan, "91")}, []int{1, 3}, nil},
+		{"Tagged", []igdb.Option{igdb.SetWhere(igdb.HasPrefix("name", "halo").And(igdb.NotNull("rating")))}, []int{1, 2}, nil},
+		{"Sort", []igdb.Option{igdb.SetOrder("rating", igdb.OrderDescending), igdb.SetLimit(2)}, []int{3, 1}, nil},
+		{"Offset", []igdb.Option{igdb.SetOffset(4)}, []int{5}, nil},
+		{"No results", []igdb.Option{igdb.SetWhere(igdb.Eq("id", 100))}, nil, igdb.ErrNotFound},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			games, err := c.Games.Index(test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Fatalf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if got := gameIDs(games); !reflect.DeepEqual(got, test.wantIDs) {
+				t.Errorf("got: <%v>, want: <%v>", got, test.wantIDs)
+			}
+		})
+	}
+}
+
+func TestServer_Services(t *testing.T) {
+	s := newGameServer(t)
+	defer s.Close()
+	c := s.Client()
+
+	g, err := c.Games.Get(3, igdb.SetFields("name", "first_release_date"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.Name != "The Legend of Zelda: Breath of the Wild" || g.FirstReleaseDate.Unix() != 1488499200 || g.Rating != 0 {
+		t.Errorf("got: <%+v>, want: Game 3 with only its name and release date", g)
+	}
+
+	games, err := c.Games.Search("zelda", igdb.SetOrder("id", igdb.OrderDescending))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := gameIDs(games); !reflect.DeepEqual(got, []int{5, 3}) {
+		t.Errorf("got: <%v>, want: <%v>", got, []int{5, 3})
+	}
+
+	ct, err := c.Games.Count(igdb.SetFilter("category", igdb.OpEquals, "0"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ct != 4 {
+		t.Errorf("got: <%v>, want: <%v>", ct, 4)
+	}
+
+	fields, err := c.Games.Fields()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"category", "first_release_date", "genres", "id", "name", "parent_game", "platforms", "rating"}
+	if !reflect.DeepEqual(fields, want) {
+		t.Errorf("got: <%v>, want: <%v>", fields, want)
+	}
+
+	p, err := c.Persons.Get(10, igdb.SetFields("*"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Name != "Somebody" {
+		t.Errorf("got: <%v>, want: <%v>", p.Name, "Somebody")
+	}
+}
+
+func TestServer_MultiQuery(t *testing.T) {
+	s := newGameServer(t)
+	defer s.Close()
+	c := s.Client()
+
+	var halo []*igdb.Game
+	var none []*igdb.Game
+	var ct int
+
+	err := c.MultiQuery().
+		Add("Halo", igdb.EndpointGame, &halo, igdb.SetWhere(igdb.HasPrefix("name", "halo")), igdb.SetLimit(2)).
+		Add("None", igdb.EndpointGame, &none, igdb.SetWhere(igdb.Eq("id", 100))).
+		Count("Count", igdb.EndpointGame, &ct, igdb.SetWhere(igdb.Gt("rating", 90))).
+		Run(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := gameIDs(halo); !reflect.DeepEqual(got, []int{1, 2}) {
+		t.Errorf("got: <%v>, want: <%v>", got, []int{1, 2})
+	}
+
+	if len(none) != 0 {
+		t.Errorf("got: <%v>, want: no Games", none)
+	}
+
+	if ct != 3 {
+		t.Errorf("got: <%v>, want: <%v>", ct, 3)
+	}
+}
+
+func TestServer_SyntaxError(t *testing.T) {
+	s := newGameServer(t)
+	defer s.Close()
+
+	_, err := s.Client().Games.Index(igdb.SetFilter("name", igdb.OpEquals, `"unterminated`))
+
+	var apiErr *igdb.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("got: <%v>, want: an *igdb.APIError", err)
+	}
+
+	if apiErr.StatusCode != http.StatusBadRequest || len(apiErr.Details) != 1 || apiErr.Details[0].Title != "Syntax Error" {
+		t.Errorf("got: <%+v>, want: a Syntax Error detail", apiErr)
+	}
+}
+
+func TestServer_Inject(t *testing.T) {
+	tests := []struct {
+		name     string
+		end      string
+		fault    Fault
+		attempts int
+		wantErr  error
+		wantReqs int
+	}{
+		{"Every request", "games", Fault{Status: http.StatusInternalServerError}, 1, igdb.ErrInternalError, 1},
+		{"Other endpoint", "genres", Fault{Status: http.StatusInternalServerError}, 1, nil, 1},
+		{"Every endpoint", "", Fault{Status: http.StatusForbidden}, 1, igdb.ErrForbidden, 1},
+		{"Custom body", "games/", Fault{Status: http.StatusUnauthorized, Body: `{"message": "nope"}`}, 1, igdb.ErrUnauthorized, 1},
+		{"Recovers after retry", "games", Fault{Status: http.StatusServiceUnavailable, Times: 2}, 3, nil, 3},
+		{"Exhausts retries", "games", Fault{Status: http.StatusTooManyRequests, Times: 3}, 3, igdb.ErrTooManyRequests, 3},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := newGameServer(t)
+			defer s.Close()
+			s.Inject(test.end, test.fault)
+
+			c := s.Client(igdb.WithRetry(igdb.RetryPolicy{MaxAttempts: test.attempts, MinBackoff: time.Millisecond}))
+
+			_, err := c.Games.Index()
+			if errors.Cause(err) != test.wantErr {
+				t.Fatalf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if got := len(s.Requests()); got != test.wantReqs {
+				t.Errorf("got: <%v> requests, want: <%v>", got, test.wantReqs)
+			}
+		})
+	}
+}
+
+func TestServer_Latency(t *testing.T) {
+	s := newGameServer(t)
+	defer s.Close()
+	c := s.Client()
+
+	s.Inject("games", Fault{Latency: time.Second, Times: 1})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := c.Games.IndexContext(ctx)
+	if errors.Cause(err) != context.DeadlineExceeded && !strings.Contains(err.Error(), "deadline exceeded") {
+		t.Fatalf("got: <%v>, want: <%v>", err, context.DeadlineExceeded)
+	}
+
+	s.SetLatency(10 * time.Millisecond)
+
+	start := time.Now()
+	if _, err := c.Games.Index(); err != nil {
+		t.Fatal(err)
+	}
+	if d := time.Since(start); d < 10*time.Millisecond {
+		t.Errorf("got: <%v>, want at least: <%v>", d, 10*time.Millisecond)
+	}
+}
+
+func TestServer_Requests(t *testing.T) {
+	s := newGameServer(t)
+	defer s.Close()
+	c := s.Client()
+
+	c.Games.Count()
+	c.Games.Index(igdb.SetLimit(1))
+
+	reqs := s.Requests()
+	if len(reqs) != 2 {
+		t.Fatalf("got: <%v> requests, want: <%v>", len(reqs), 2)
+	}
+
+	if reqs[0].Endpoint != "games/count" || reqs[1].Endpoint != "games" || reqs[1].Query != "limit 1; " {
+		t.Errorf("got: <%+v>, want: a count request and an index request", reqs)
+	}
+
+	s.Reset()
+	if len(s.Requests()) != 0 {
+		t.Errorf("got: <%v> requests, want none after Reset", len(s.Requests()))
+	}
+
+	if _, err := c.Games.Index(); errors.Cause(err) != igdb.ErrNotFound {
+		t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), igdb.ErrNotFound)
+	}
+}
+
+func TestServer_Load(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	games := []*igdb.Game{{ID: 1, Name: "One"}, {ID: 2, Name: "Two"}}
+	if err := s.Load("games/", games); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := s.LoadJSON("games", []byte(`{"id": 3, "name": "Three"}`)); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := s.LoadJSON("games", []byte(`[{"id": 4`)); err == nil {
+		t.Error("got: no error, want an error loading invalid JSON")
+	}
+
+	if err := s.LoadFile("games", "test_data/missing.json"); err == nil {
+		t.Error("got: no error, want an error loading a missing file")
+	}
+
+	got, err := s.Client().Games.Index(igdb.SetFields("name"), igdb.SetWhere(igdb.Gt("id", 1)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(got) != 2 || got[0].Name != "Two" || got[1].Name != "Three" {
+		t.Errorf("got: <%v>, want: Games Two and Three", got)
+	}
+}
@@ -0,0 +1,460 @@
+package igdbtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// expr is a parsed where expression evaluated against a single record.
+type expr interface {
+	eval(r record) bool
+}
+
+// andExpr matches records matched by every one of its expressions.
+type andExpr []expr
+
+func (e andExpr) eval(r record) bool {
+	for _, sub := range e {
+		if !sub.eval(r) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// orExpr matches records matched by any one of its expressions.
+type orExpr []expr
+
+func (e orExpr) eval(r record) bool {
+	for _, sub := range e {
+		if sub.eval(r) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// notExpr matches records not matched by its expression.
+type notExpr struct {
+	e expr
+}
+
+func (e notExpr) eval(r record) bool {
+	return !e.e.eval(r)
+}
+
+// Kinds of operands of a comparison.
+const (
+	kindScalar = iota
+	kindAll    // [a,b]: the array contains every value
+	kindAny    // (a,b): the array contains at least one value
+	kindExact  // {a,b}: the array contains exactly the values
+)
+
+// cmpExpr compares a field of a record to an operand.
+type cmpExpr struct {
+	field  string
+	op     string
+	kind   int
+	vals   []interface{}
+	prefix bool // the pattern of ~ may be preceded by anything
+	suffix bool // the pattern of ~ may be followed by anything
+}
+
+func (e cmpExpr) eval(r record) bool {
+	v, ok := lookup(r, e.field)
+	if !ok {
+		v = nil
+	}
+
+	var elems []interface{}
+	switch a := v.(type) {
+	case nil:
+	case []interface{}:
+		elems = a
+	default:
+		elems = []interface{}{a}
+	}
+
+	if e.kind == kindScalar && e.vals[0] == nil {
+		null := len(elems) == 0
+		return null == (e.op == "=")
+	}
+
+	neg := strings.HasPrefix(e.op, "!")
+	op := strings.TrimPrefix(e.op, "!")
+
+	var match bool
+	switch {
+	case op == "~":
+		match = e.matchAny(elems)
+	case op == "=" && e.kind == kindScalar:
+		match = contains(elems, e.vals[0])
+	case op == "=" && e.kind == kindAll:
+		match = true
+		for _, val := range e.vals {
+			match = match && contains(elems, val)
+		}
+	case op == "=" && e.kind == kindAny:
+		for _, val := range e.vals {
+			match = match || contains(elems, val)
+		}
+	case op == "=" && e.kind == kindExact:
+		match = len(elems) == len(e.vals)
+		for _, val := range e.vals {
+			match = match && contains(elems, val)
+		}
+	default:
+		match = e.compareAny(op, elems)
+	}
+
+	return match != neg
+}
+
+// matchAny returns true if any of the provided values matches the pattern
+// of the comparison, ignoring case.
+func (e cmpExpr) matchAny(elems []interface{}) bool {
+	pat, _ := e.vals[0].(string)
+	pat = strings.ToLower(pat)
+
+	for _, el := range elems {
+		s, ok := el.(string)
+		if !ok {
+			continue
+		}
+
+		s = strings.ToLower(s)
+		switch {
+		case e.prefix && e.suffix && strings.Contains(s, pat),
+			e.prefix && !e.suffix && strings.HasSuffix(s, pat),
+			!e.prefix && e.suffix && strings.HasPrefix(s, pat),
+			!e.prefix && !e.suffix && s == pat:
+			return true
+		}
+	}
+
+	return false
+}
+
+// compareAny returns true if any of the provided values satisfies the
+// provided numeric comparison against the operand of the comparison.
+func (e cmpExpr) compareAny(op string, elems []interface{}) bool {
+	y, ok := number(e.vals[0])
+	if !ok {
+		return false
+	}
+
+	for _, el := range elems {
+		x, ok := number(el)
+		if !ok {
+			continue
+		}
+
+		switch {
+		case op == ">" && x > y, op == ">=" && x >= y, op == "<" && x < y, op == "<=" && x <= y:
+			return true
+		}
+	}
+
+	return false
+}
+
+// contains returns true if the provided values contain the provided value.
+func contains(elems []interface{}, val interface{}) bool {
+	for _, el := range elems {
+		if equal(el, val) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseWhere parses the provided where expression.
+func parseWhere(s string) (expr, error) {
+	p := &parser{s: s}
+
+	e, err := p.or()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.skip(); !p.done() {
+		return nil, fmt.Errorf("unexpected %q in where", s[p.i:])
+	}
+
+	return e, nil
+}
+
+// parser is a recursive descent parser of where expressions.
+type parser struct {
+	s string
+	i int
+}
+
+// done returns true if the whole input was consumed.
+func (p *parser) done() bool {
+	return p.i >= len(p.s)
+}
+
+// skip skips any whitespace.
+func (p *parser) skip() {
+	for !p.done() && strings.IndexByte(" \t\r\n", p.s[p.i]) >= 0 {
+		p.i++
+	}
+}
+
+// peek skips any whitespace and returns the next byte, if any.
+func (p *parser) peek() byte {
+	if p.skip(); p.done() {
+		return 0
+	}
+
+	return p.s[p.i]
+}
+
+// or parses expressions separated by |.
+func (p *parser) or() (expr, error) {
+	var es orExpr
+	for {
+		e, err := p.and()
+		if err != nil {
+			return nil, err
+		}
+		es = append(es, e)
+
+		if p.peek() != '|' {
+			break
+		}
+		p.i++
+	}
+
+	if len(es) == 1 {
+		return es[0], nil
+	}
+
+	return es, nil
+}
+
+// and parses expressions separated by &.
+func (p *parser) and() (expr, error) {
+	var es andExpr
+	for {
+		e, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		es = append(es, e)
+
+		if p.peek() != '&' {
+			break
+		}
+		p.i++
+	}
+
+	if len(es) == 1 {
+		return es[0], nil
+	}
+
+	return es, nil
+}
+
+// unary parses a negated expression, a parenthesized expression,
+// or a comparison.
+func (p *parser) unary() (expr, error) {
+	switch p.peek() {
+	case '!':
+		p.i++
+		if p.peek() != '(' {
+			return nil, fmt.Errorf("expected ( after ! at %d", p.i)
+		}
+		e, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return notExpr{e}, nil
+	case '(':
+		p.i++
+		e, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek() != ')' {
+			return nil, fmt.Errorf("expected ) at %d", p.i)
+		}
+		p.i++
+		return e, nil
+	}
+
+	return p.comparison()
+}
+
+// comparison parses a field, an operator, and an operand.
+func (p *parser) comparison() (expr, error) {
+	p.skip()
+	start := p.i
+	for !p.done() && isFieldByte(p.s[p.i]) {
+		p.i++
+	}
+	if start == p.i {
+		return nil, fmt.Errorf("expected field at %d", start)
+	}
+
+	e := cmpExpr{field: p.s[start:p.i]}
+
+	p.skip()
+	for _, op := range []string{"!=", ">=", "<=", "!~", "=", ">", "<", "~"} {
+		if strings.HasPrefix(p.s[p.i:], op) {
+			e.op = op
+			p.i += len(op)
+			break
+		}
+	}
+	if e.op == "" {
+		return nil, fmt.Errorf("expected operator after %q", e.field)
+	}
+
+	switch p.peek() {
+	case '[':
+		e.kind = kindAll
+	case '(':
+		e.kind = kindAny
+	case '{':
+		e.kind = kindExact
+	}
+
+	if e.kind != kindScalar {
+		vals, err := p.list()
+		if err != nil {
+			return nil, err
+		}
+		if (e.op != "=" && e.op != "!=") || len(vals) == 0 {
+			return nil, fmt.Errorf("invalid list operand for %q", e.field)
+		}
+		e.vals = vals
+		return e, nil
+	}
+
+	if strings.HasSuffix(e.op, "~") {
+		if p.peek() == '*' {
+			e.prefix = true
+			p.i++
+		}
+		if p.peek() != '"' {
+			return nil, fmt.Errorf("expected string after ~ for %q", e.field)
+		}
+		s, err := p.quoted()
+		if err != nil {
+			return nil, err
+		}
+		if !p.done() && p.s[p.i] == '*' {
+			e.suffix = true
+			p.i++
+		}
+		e.vals = []interface{}{s}
+		return e, nil
+	}
+
+	v, err := p.value()
+	if err != nil {
+		return nil, err
+	}
+	e.vals = []interface{}{v}
+
+	if v == nil && e.op != "=" && e.op != "!=" {
+		return nil, fmt.Errorf("invalid null operand for %q", e.field)
+	}
+
+	return e, nil
+}
+
+// list parses a list of values enclosed in brackets, parentheses, or braces.
+func (p *parser) list() ([]interface{}, error) {
+	closing := map[byte]byte{'[': ']', '(': ')', '{': '}'}[p.s[p.i]]
+	p.i++
+
+	var vals []interface{}
+	for {
+		if p.peek() == closing {
+			p.i++
+			return vals, nil
+		}
+
+		if len(vals) > 0 {
+			if p.peek() != ',' {
+				return nil, fmt.Errorf("expected , or %c at %d", closing, p.i)
+			}
+			p.i++
+		}
+
+		v, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		vals = append(vals, v)
+	}
+}
+
+// value parses a quoted string, a number, a boolean, null, or a bare word,
+// which is treated as a string.
+func (p *parser) value() (interface{}, error) {
+	if p.peek() == '"' {
+		return p.quoted()
+	}
+
+	start := p.i
+	for !p.done() && strings.IndexByte(" \t\r\n&|,)]}", p.s[p.i]) < 0 {
+		p.i++
+	}
+
+	word := p.s[start:p.i]
+	switch word {
+	case "":
+		return nil, fmt.Errorf("expected value at %d", start)
+	case "null":
+		return nil, nil
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	}
+
+	if _, err := json.Number(word).Float64(); err == nil && strings.IndexByte("-.0123456789", word[0]) >= 0 {
+		return json.Number(word), nil
+	}
+
+	return word, nil
+}
+
+// quoted parses a double quoted string with backslash escapes.
+func (p *parser) quoted() (string, error) {
+	if p.peek() != '"' {
+		return "", fmt.Errorf("expected string at %d", p.i)
+	}
+	p.i++
+
+	b := strings.Builder{}
+	for ; !p.done(); p.i++ {
+		c := p.s[p.i]
+		switch {
+		case c == '\\' && p.i+1 < len(p.s):
+			p.i++
+			b.WriteByte(p.s[p.i])
+		case c == '"':
+			p.i++
+			return b.String(), nil
+		default:
+			b.WriteByte(c)
+		}
+	}
+
+	return "", fmt.Errorf("unterminated string")
+}
+
+// isFieldByte returns true if the provided byte may appear in a field name.
+func isFieldByte(c byte) bool {
+	return c == '_' || c == '.' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
@@ -0,0 +1,100 @@
+package igdbtest
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// whereRecord is the record every where expression is evaluated against.
+const whereRecord = `{
+	"id": 7,
+	"name": "Halo: Combat Evolved",
+	"rating": 92.5,
+	"platforms": [6, 11],
+	"tags": [],
+	"checksum_valid": true,
+	"cover": null,
+	"websites": [{"category": 1, "url": "https://halo.com"}, {"category": 13}]
+}`
+
+func TestParseWhere(t *testing.T) {
+	var r record
+	if err := json.Unmarshal([]byte(whereRecord), &r); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		where   string
+		want    bool
+		wantErr bool
+	}{
+		{"Equals number", "id = 7", true, false},
+		{"Equals float", "rating = 92.5", true, false},
+		{"Not equals", "id != 7", false, false},
+		{"Missing field not equals", "category != 0", true, false},
+		{"Missing field equals", "category = 0", false, false},
+		{"Greater than", "rating > 90", true, false},
+		{"Greater than equal", "id >= 7", true, false},
+		{"Less than", "rating < 90", false, false},
+		{"Less than equal", "id <= 6", false, false},
+		{"Equals string", `name = "Halo: Combat Evolved"`, true, false},
+		{"Equals string is case sensitive", `name = "halo: combat evolved"`, false, false},
+		{"Equals bare word", `checksum_valid = true`, true, false},
+		{"Array equals scalar", "platforms = 11", true, false},
+		{"Array not equals scalar", "platforms != 11", false, false},
+		{"Contains all", "platforms = [6,11]", true, false},
+		{"Contains all missing one", "platforms = [6,12]", false, false},
+		{"Not contains all", "platforms != [6,12]", true, false},
+		{"Contains any", "platforms = (12,11)", true, false},
+		{"Contains none", "platforms != (12,13)", true, false},
+		{"Contains exactly", "platforms = {11,6}", true, false},
+		{"Contains exactly too few", "platforms = {6}", false, false},
+		{"Scalar contains any", "id = (1,7)", true, false},
+		{"Null", "cover = null", true, false},
+		{"Missing is null", "summary = null", true, false},
+		{"Empty array is null", "tags = null", true, false},
+		{"Not null", "name != null", true, false},
+		{"Prefix", `name ~ "halo"*`, true, false},
+		{"Suffix", `name ~ *"EVOLVED"`, true, false},
+		{"Substring", `name ~ *"combat"*`, true, false},
+		{"Equal fold", `name ~ "HALO: COMBAT EVOLVED"`, true, false},
+		{"Equal fold partial", `name ~ "halo"`, false, false},
+		{"Not matching", `name !~ *"zelda"*`, true, false},
+		{"Nested field", "websites.category = 13", true, false},
+		{"Nested field missing", "websites.url = null", false, false},
+		{"And", "id = 7 & rating > 90", true, false},
+		{"Or", "id = 1 | rating > 90", true, false},
+		{"Precedence", "id = 1 & rating > 90 | id = 7", true, false},
+		{"Group", "id = 1 & (rating > 90 | id = 7)", false, false},
+		{"Not", "!(id = 1 | id = 2)", true, false},
+		{"Nested not", "!(!(id = 7))", true, false},
+		{"Missing operator", "id 7", false, true},
+		{"Missing operand", "id =", false, true},
+		{"Missing field", "= 7", false, true},
+		{"Unbalanced group", "(id = 7", false, true},
+		{"Trailing input", "id = 7 )", false, true},
+		{"Unterminated string", `name = "Halo`, false, true},
+		{"Unterminated list", "platforms = (6,11", false, true},
+		{"Empty list", "platforms = ()", false, true},
+		{"List with comparison", "platforms > (6)", false, true},
+		{"Null with comparison", "rating > null", false, true},
+		{"Pattern without string", "name ~ halo", false, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			e, err := parseWhere(test.where)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("got: <%v>, want error: <%v>", err, test.wantErr)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if got := e.eval(r); got != test.want {
+				t.Errorf("got: <%v>, want: <%v>", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,174 @@
+// Command apigen generates the interfaces of the services of the igdb package
+// and their mock implementations in the igdbmock package. The services are
+// read from the fields of the igdb Client and the Service or SearchableService
+// their types embed, so apigen only needs to be run again once a service is
+// added or removed.
+//
+// Run apigen from the root of the igdb package with go generate.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+// svc is a service of the igdb Client.
+type svc struct {
+	// Field is the name of the Client field holding the service (e.g. "Games").
+	Field string
+	// Type is the type of the service (e.g. "GameService").
+	Type string
+	// Entity is the type of the objects of the service (e.g. "Game").
+	Entity string
+	// Search is true if the service can search its objects.
+	Search bool
+}
+
+// Name returns the name of the interface of the service (e.g. "GameAPI").
+func (s svc) Name() string {
+	return s.Entity + "API"
+}
+
+func main() {
+	dir := flag.String("dir", ".", "directory of the igdb package")
+	api := flag.String("api", "api.go", "output file of the interfaces, relative to dir")
+	mock := flag.String("mock", "igdbmock/mock.go", "output file of the mocks, relative to dir")
+	flag.Parse()
+
+	svcs, err := parse(*dir)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for path, tmpl := range map[string]*template.Template{*api: apiTmpl, *mock: mockTmpl} {
+		b, err := render(tmpl, svcs)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if err := ioutil.WriteFile(filepath.Join(*dir, path), b, 0644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// parse returns the services of the Client of the igdb package
+// in the provided directory, in the order of the Client fields.
+func parse(dir string) ([]svc, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	fset := token.NewFileSet()
+	var files []*ast.File
+	for _, p := range paths {
+		if strings.HasSuffix(p, "_test.go") || filepath.Base(p) == "api.go" {
+			continue
+		}
+
+		f, err := parser.ParseFile(fset, p, nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, f)
+	}
+
+	// entities maps the type of each service to the type of its objects,
+	// as embedded with Service[T], and search to whether it embeds
+	// SearchableService[T] instead.
+	entities := make(map[string]string)
+	search := make(map[string]bool)
+	var client *ast.StructType
+
+	for _, f := range files {
+		for _, decl := range f.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok {
+				continue
+			}
+
+			for _, spec := range gen.Specs {
+				ts, ok := spec.(*ast.TypeSpec)
+				if !ok {
+					continue
+				}
+
+				st, ok := ts.Type.(*ast.StructType)
+				if !ok {
+					continue
+				}
+
+				if ts.Name.Name == "Client" {
+					client = st
+					continue
+				}
+
+				for _, field := range st.Fields.List {
+					idx, ok := field.Type.(*ast.IndexExpr)
+					if !ok || len(field.Names) != 0 {
+						continue
+					}
+
+					switch base := fmt.Sprint(idx.X); base {
+					case "Service", "SearchableService":
+						entities[ts.Name.Name] = fmt.Sprint(idx.Index)
+						search[ts.Name.Name] = base == "SearchableService"
+					}
+				}
+			}
+		}
+	}
+
+	if client == nil {
+		return nil, fmt.Errorf("apigen: cannot find the Client type in %s", dir)
+	}
+
+	var svcs []svc
+	for _, field := range client.Fields.List {
+		star, ok := field.Type.(*ast.StarExpr)
+		if !ok {
+			continue
+		}
+
+		typ := fmt.Sprint(star.X)
+		ent, ok := entities[typ]
+		if !ok || len(field.Names) != 1 {
+			continue
+		}
+
+		svcs = append(svcs, svc{Field: field.Names[0].Name, Type: typ, Entity: ent, Search: search[typ]})
+	}
+
+	if len(svcs) == 0 {
+		return nil, fmt.Errorf("apigen: cannot find any services in %s", dir)
+	}
+
+	return svcs, nil
+}
+
+// render executes the provided template with the provided services
+// and formats the result.
+func render(tmpl *template.Template, svcs []svc) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, svcs); err != nil {
+		return nil, err
+	}
+
+	b, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("apigen: cannot format %s: %v", tmpl.Name(), err)
+	}
+
+	return b, nil
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"text/template"
+)
+
+func TestParse(t *testing.T) {
+	svcs, err := parse(filepath.Join("..", ".."))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	found := make(map[string]svc)
+	for _, s := range svcs {
+		found[s.Field] = s
+	}
+
+	var tests = []struct {
+		field string
+		want  svc
+	}{
+		{"Games", svc{Field: "Games", Type: "GameService", Entity: "Game", Search: true}},
+		{"Covers", svc{Field: "Covers", Type: "CoverService", Entity: "Cover", Search: false}},
+		{"PulseURLs", svc{Field: "PulseURLs", Type: "PulseURLService", Entity: "PulseURL", Search: true}},
+		{"TestDummies", svc{Field: "TestDummies", Type: "TestDummyService", Entity: "TestDummy", Search: false}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.field, func(t *testing.T) {
+			if got := found[test.field]; got != test.want {
+				t.Errorf("got: <%v>, want: <%v>", got, test.want)
+			}
+		})
+	}
+}
+
+func TestGenerated(t *testing.T) {
+	dir := filepath.Join("..", "..")
+
+	svcs, err := parse(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for path, tmpl := range map[string]*template.Template{"api.go": apiTmpl, "igdbmock/mock.go": mockTmpl} {
+		t.Run(path, func(t *testing.T) {
+			want, err := render(tmpl, svcs)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := ioutil.ReadFile(filepath.Join(dir, path))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !bytes.Equal(got, want) {
+				t.Errorf("got: outdated %s, want: output of apigen; run go generate", path)
+			}
+		})
+	}
+}
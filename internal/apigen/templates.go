@@ -0,0 +1,249 @@
+package main
+
+import "text/template"
+
+// header marks the output of apigen as generated.
+const header = `// Code generated by "go run ./internal/apigen"; DO NOT EDIT.
+
+`
+
+// apiTmpl renders the interfaces of the services and the Client.
+var apiTmpl = template.Must(template.New("api.go").Parse(header + `package igdb
+
+import "context"
+
+// API is the interface implemented by Client. Depend on API instead of *Client
+// to substitute a mock, such as igdbmock.Client, in tests. Each service of the
+// Client is returned as its interface by the method named after its field.
+//
+// The interfaces only cover the basic operations of the services, those that
+// make a single request. Iterate, IterateSearch, Scan, Loader, GetDetails, and
+// Calendar are only available on the services themselves.
+type API interface {
+{{- range .}}
+	{{.Field}}API() {{.Name}}
+{{- end}}
+
+	Search(qry string, opts ...Option) ([]*SearchResult, error)
+	SearchContext(ctx context.Context, qry string, opts ...Option) ([]*SearchResult, error)
+	Status() (*Status, error)
+	StatusContext(ctx context.Context) (*Status, error)
+	ResolveTags(ctx context.Context, tags []Tag, opts ...Option) (*TaggedObjects, error)
+}
+
+var _ API = (*Client)(nil)
+{{range .}}
+// {{.Field}}API returns the {{.Field}} service of the Client as the {{.Name}} interface.
+func (c *Client) {{.Field}}API() {{.Name}} {
+	return c.{{.Field}}
+}
+{{end}}
+{{- range .}}
+// {{.Name}} is the interface implemented by {{.Type}}. Depend on {{.Name}}
+// instead of *{{.Type}} to substitute a mock, such as igdbmock.{{.Name}}, in tests.
+// It only covers the basic operations of the service.
+type {{.Name}} interface {
+	Get(id int, opts ...Option) (*{{.Entity}}, error)
+	GetContext(ctx context.Context, id int, opts ...Option) (*{{.Entity}}, error)
+	List(ids []int, opts ...Option) ([]*{{.Entity}}, error)
+	ListContext(ctx context.Context, ids []int, opts ...Option) ([]*{{.Entity}}, error)
+	Index(opts ...Option) ([]*{{.Entity}}, error)
+	IndexContext(ctx context.Context, opts ...Option) ([]*{{.Entity}}, error)
+{{- if .Search}}
+	Search(qry string, opts ...Option) ([]*{{.Entity}}, error)
+	SearchContext(ctx context.Context, qry string, opts ...Option) ([]*{{.Entity}}, error)
+{{- end}}
+	Count(opts ...Option) (int, error)
+	CountContext(ctx context.Context, opts ...Option) (int, error)
+	Fields() ([]string, error)
+	FieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ {{.Name}} = (*{{.Type}})(nil)
+{{end}}`))
+
+// mockTmpl renders the mocks of the services and the Client.
+var mockTmpl = template.Must(template.New("mock.go").Parse(header + `package igdbmock
+
+import (
+	"context"
+
+	"github.com/Henry-Sarabia/igdb"
+)
+
+// Client is a mock igdb.API. Every service of the Client is a mock of its own,
+// created by NewClient, and the calls to every service are recorded by the
+// Client as well. Set the Func fields of the Client and of its services to
+// script their responses.
+type Client struct {
+{{- range .}}
+	{{.Field}} *{{.Name}}
+{{- end}}
+
+	SearchFunc      func(ctx context.Context, qry string, opts ...igdb.Option) ([]*igdb.SearchResult, error)
+	StatusFunc      func(ctx context.Context) (*igdb.Status, error)
+	ResolveTagsFunc func(ctx context.Context, tags []igdb.Tag, opts ...igdb.Option) (*igdb.TaggedObjects, error)
+
+	calls callLog
+}
+
+var _ igdb.API = (*Client)(nil)
+
+// NewClient returns a new Client with a mock for every service.
+func NewClient() *Client {
+	c := &Client{}
+{{- range .}}
+	c.{{.Field}} = &{{.Name}}{calls: callLog{parent: &c.calls}}
+{{- end}}
+
+	return c
+}
+{{range .}}
+// {{.Field}}API returns the {{.Field}} mock of the Client.
+func (c *Client) {{.Field}}API() igdb.{{.Name}} {
+	return c.{{.Field}}
+}
+{{end}}
+{{- range .}}
+// {{.Name}} is a mock igdb.{{.Name}}. Set its Func fields to script its responses;
+// the methods with and without a context share a Func. Calling a method
+// without a Func returns an error wrapping ErrNotScripted.
+type {{.Name}} struct {
+	GetFunc    func(ctx context.Context, id int, opts ...igdb.Option) (*igdb.{{.Entity}}, error)
+	ListFunc   func(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.{{.Entity}}, error)
+	IndexFunc  func(ctx context.Context, opts ...igdb.Option) ([]*igdb.{{.Entity}}, error)
+{{- if .Search}}
+	SearchFunc func(ctx context.Context, qry string, opts ...igdb.Option) ([]*igdb.{{.Entity}}, error)
+{{- end}}
+	CountFunc  func(ctx context.Context, opts ...igdb.Option) (int, error)
+	FieldsFunc func(ctx context.Context) ([]string, error)
+
+	calls callLog
+}
+
+var _ igdb.{{.Name}} = (*{{.Name}})(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *{{.Name}}) Calls() []Call {
+	return m.calls.get()
+}
+
+// Reset forgets the calls made to the mock.
+func (m *{{.Name}}) Reset() {
+	m.calls.reset()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *{{.Name}}) Get(id int, opts ...igdb.Option) (*igdb.{{.Entity}}, error) {
+	return m.get(context.Background(), "Get", id, opts...)
+}
+
+// GetContext records the call and returns the response of GetFunc.
+func (m *{{.Name}}) GetContext(ctx context.Context, id int, opts ...igdb.Option) (*igdb.{{.Entity}}, error) {
+	return m.get(ctx, "GetContext", id, opts...)
+}
+
+func (m *{{.Name}}) get(ctx context.Context, method string, id int, opts ...igdb.Option) (*igdb.{{.Entity}}, error) {
+	m.calls.record("{{.Name}}", method, opts, id)
+	if m.GetFunc == nil {
+		return nil, notScripted("{{.Name}}", method)
+	}
+
+	return m.GetFunc(ctx, id, opts...)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *{{.Name}}) List(ids []int, opts ...igdb.Option) ([]*igdb.{{.Entity}}, error) {
+	return m.list(context.Background(), "List", ids, opts...)
+}
+
+// ListContext records the call and returns the response of ListFunc.
+func (m *{{.Name}}) ListContext(ctx context.Context, ids []int, opts ...igdb.Option) ([]*igdb.{{.Entity}}, error) {
+	return m.list(ctx, "ListContext", ids, opts...)
+}
+
+func (m *{{.Name}}) list(ctx context.Context, method string, ids []int, opts ...igdb.Option) ([]*igdb.{{.Entity}}, error) {
+	m.calls.record("{{.Name}}", method, opts, ids)
+	if m.ListFunc == nil {
+		return nil, notScripted("{{.Name}}", method)
+	}
+
+	return m.ListFunc(ctx, ids, opts...)
+}
+
+// Index records the call and returns the response of IndexFunc.
+func (m *{{.Name}}) Index(opts ...igdb.Option) ([]*igdb.{{.Entity}}, error) {
+	return m.index(context.Background(), "Index", opts...)
+}
+
+// IndexContext records the call and returns the response of IndexFunc.
+func (m *{{.Name}}) IndexContext(ctx context.Context, opts ...igdb.Option) ([]*igdb.{{.Entity}}, error) {
+	return m.index(ctx, "IndexContext", opts...)
+}
+
+func (m *{{.Name}}) index(ctx context.Context, method string, opts ...igdb.Option) ([]*igdb.{{.Entity}}, error) {
+	m.calls.record("{{.Name}}", method, opts)
+	if m.IndexFunc == nil {
+		return nil, notScripted("{{.Name}}", method)
+	}
+
+	return m.IndexFunc(ctx, opts...)
+}
+{{if .Search}}
+// Search records the call and returns the response of SearchFunc.
+func (m *{{.Name}}) Search(qry string, opts ...igdb.Option) ([]*igdb.{{.Entity}}, error) {
+	return m.search(context.Background(), "Search", qry, opts...)
+}
+
+// SearchContext records the call and returns the response of SearchFunc.
+func (m *{{.Name}}) SearchContext(ctx context.Context, qry string, opts ...igdb.Option) ([]*igdb.{{.Entity}}, error) {
+	return m.search(ctx, "SearchContext", qry, opts...)
+}
+
+func (m *{{.Name}}) search(ctx context.Context, method string, qry string, opts ...igdb.Option) ([]*igdb.{{.Entity}}, error) {
+	m.calls.record("{{.Name}}", method, opts, qry)
+	if m.SearchFunc == nil {
+		return nil, notScripted("{{.Name}}", method)
+	}
+
+	return m.SearchFunc(ctx, qry, opts...)
+}
+{{end}}
+// Count records the call and returns the response of CountFunc.
+func (m *{{.Name}}) Count(opts ...igdb.Option) (int, error) {
+	return m.count(context.Background(), "Count", opts...)
+}
+
+// CountContext records the call and returns the response of CountFunc.
+func (m *{{.Name}}) CountContext(ctx context.Context, opts ...igdb.Option) (int, error) {
+	return m.count(ctx, "CountContext", opts...)
+}
+
+func (m *{{.Name}}) count(ctx context.Context, method string, opts ...igdb.Option) (int, error) {
+	m.calls.record("{{.Name}}", method, opts)
+	if m.CountFunc == nil {
+		return 0, notScripted("{{.Name}}", method)
+	}
+
+	return m.CountFunc(ctx, opts...)
+}
+
+// Fields records the call and returns the response of FieldsFunc.
+func (m *{{.Name}}) Fields() ([]string, error) {
+	return m.fields(context.Background(), "Fields")
+}
+
+// FieldsContext records the call and returns the response of FieldsFunc.
+func (m *{{.Name}}) FieldsContext(ctx context.Context) ([]string, error) {
+	return m.fields(ctx, "FieldsContext")
+}
+
+func (m *{{.Name}}) fields(ctx context.Context, method string) ([]string, error) {
+	m.calls.record("{{.Name}}", method, nil)
+	if m.FieldsFunc == nil {
+		return nil, notScripted("{{.Name}}", method)
+	}
+
+	return m.FieldsFunc(ctx)
+}
+{{end}}`))
@@ -0,0 +1,274 @@
+package igdb
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultLoaderWait is how long a loader collects lookups before
+// retrieving them in a single API call.
+const DefaultLoaderWait = 2 * time.Millisecond
+
+// batchFunc retrieves the objects identified by the provided IDs
+// using the provided options.
+type batchFunc[T any] func(ctx context.Context, ids []int, opts ...Option) ([]*T, error)
+
+// Loader batches lookups of objects by ID. It collects the IDs looked up within
+// a short window and retrieves them with as few API calls as possible, each
+// retrieving up to a full batch of IDs. Every lookup is cached for the lifetime
+// of the Loader, so an ID is only ever retrieved once. A Loader is safe for
+// concurrent use and is created with the Loader method of a service.
+type Loader[T any] struct {
+	ctx   context.Context
+	fetch batchFunc[T]
+	id    idFunc[T]
+	name  string
+	opts  []Option
+	size  int
+	wait  time.Duration
+
+	mu      sync.Mutex
+	results map[int]*loadResult[T]
+	batch   *loadBatch[T]
+	err     error
+}
+
+// loadResult is the eventual result of a single lookup.
+type loadResult[T any] struct {
+	done chan struct{}
+	val  *T
+	err  error
+}
+
+// loadBatch is a batch of lookups waiting to be retrieved.
+type loadBatch[T any] struct {
+	ids     []int
+	results []*loadResult[T]
+	timer   *time.Timer
+}
+
+// newLoader returns a Loader that retrieves objects using the provided batch
+// and ID functions. The provided name of the objects is used in error
+// messages. The batch size is taken from the SetLimit functional option,
+// if provided.
+func newLoader[T any](ctx context.Context, fetch batchFunc[T], id idFunc[T], name string, opts []Option) *Loader[T] {
+	l := &Loader[T]{
+		ctx:     ctx,
+		fetch:   fetch,
+		id:      id,
+		name:    name,
+		opts:    opts,
+		size:    DefaultPageSize,
+		wait:    DefaultLoaderWait,
+		results: make(map[int]*loadResult[T]),
+	}
+
+	clauses, err := applyOptions(opts...)
+	if err != nil {
+		l.err = errors.Wrap(err, "cannot load with invalid options")
+		return l
+	}
+
+	if lim, ok := clauses["limit"]; ok {
+		l.size, _ = strconv.Atoi(lim)
+	}
+
+	return l
+}
+
+// Loader returns a loader that batches lookups of objects by ID. Lookups made
+// within a short window are retrieved together with a single call to List,
+// split into batches no larger than the limit set with the SetLimit functional
+// option. Every object is cached for the lifetime of the loader, so a loader
+// should be scoped to a single request or task. The provided functional
+// options are applied to every List call and the provided context is used
+// for every lookup.
+func (s *Service[T]) Loader(ctx context.Context, opts ...Option) *Loader[T] {
+	return newLoader(ctx, s.ListContext, s.id, s.name, opts)
+}
+
+// enqueue adds a lookup of the provided ID to the current batch, unless the ID
+// was already looked up, and returns the eventual result of the lookup.
+func (l *Loader[T]) enqueue(id int) *loadResult[T] {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if r, ok := l.results[id]; ok {
+		return r
+	}
+
+	r := &loadResult[T]{done: make(chan struct{})}
+	switch {
+	case l.err != nil:
+		r.err = l.err
+		close(r.done)
+		return r
+	case id < 0:
+		r.err = ErrNegativeID
+		close(r.done)
+		return r
+	}
+
+	l.results[id] = r
+
+	if l.batch == nil {
+		b := &loadBatch[T]{}
+		b.timer = time.AfterFunc(l.wait, func() { l.dispatch(b) })
+		l.batch = b
+	}
+
+	l.batch.ids = append(l.batch.ids, id)
+	l.batch.results = append(l.batch.results, r)
+
+	if len(l.batch.ids) >= l.size {
+		b := l.batch
+		l.batch = nil
+		b.timer.Stop()
+		go l.run(b)
+	}
+
+	return r
+}
+
+// dispatch retrieves the provided batch if it is still the current batch.
+func (l *Loader[T]) dispatch(b *loadBatch[T]) {
+	l.mu.Lock()
+	if l.batch != b {
+		l.mu.Unlock()
+		return
+	}
+	l.batch = nil
+	l.mu.Unlock()
+
+	l.run(b)
+}
+
+// Flush retrieves the lookups collected so far without waiting any longer.
+func (l *Loader[T]) Flush() {
+	l.mu.Lock()
+	b := l.batch
+	l.batch = nil
+	l.mu.Unlock()
+
+	if b != nil {
+		b.timer.Stop()
+		go l.run(b)
+	}
+}
+
+// run retrieves the provided batch and completes each of its lookups. IDs that
+// are missing from the results complete with a not found error. Lookups that
+// fail for any other reason are forgotten so that they can be retried.
+func (l *Loader[T]) run(b *loadBatch[T]) {
+	opts := append(l.opts[:len(l.opts):len(l.opts)], SetLimit(len(b.ids)))
+
+	res, err := l.fetch(l.ctx, b.ids, opts...)
+	if err != nil && !isEmptyResult(err) {
+		l.mu.Lock()
+		for _, id := range b.ids {
+			delete(l.results, id)
+		}
+		l.mu.Unlock()
+	}
+
+	found := make(map[int]*T, len(res))
+	for _, v := range res {
+		found[l.id(v)] = v
+	}
+
+	for i, id := range b.ids {
+		r := b.results[i]
+		switch v, ok := found[id]; {
+		case err != nil && !isEmptyResult(err):
+			r.err = errors.Wrapf(err, "cannot load %s with ID %d", l.name, id)
+		case !ok:
+			r.err = errors.Wrapf(ErrNotFound, "cannot load %s with ID %d", l.name, id)
+		default:
+			r.val = v
+		}
+		close(r.done)
+	}
+}
+
+// await waits for the provided lookup to complete or for
+// the context of the loader to be canceled.
+func (l *Loader[T]) await(r *loadResult[T]) (*T, error) {
+	select {
+	case <-r.done:
+		return r.val, r.err
+	case <-l.ctx.Done():
+		return nil, l.ctx.Err()
+	}
+}
+
+// Load returns the object identified by the provided IGDB ID, waiting for the
+// batch it belongs to. If the ID does not match any objects, an error wrapping
+// ErrNotFound is returned.
+func (l *Loader[T]) Load(id int) (*T, error) {
+	return l.await(l.enqueue(id))
+}
+
+// LoadMany returns the objects identified by the provided IGDB IDs, in the
+// order of the IDs. Any ID that does not match an object is ignored.
+func (l *Loader[T]) LoadMany(ids []int) ([]*T, error) {
+	if len(ids) < 1 {
+		return nil, ErrEmptyIDs
+	}
+
+	results := make([]*loadResult[T], len(ids))
+	for i, id := range ids {
+		results[i] = l.enqueue(id)
+	}
+
+	vals := make([]*T, 0, len(ids))
+	for _, r := range results {
+		v, err := l.await(r)
+		if isEmptyResult(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		vals = append(vals, v)
+	}
+
+	return vals, nil
+}
+
+// Wait sets how long the loader collects lookups before retrieving them.
+// The default is DefaultLoaderWait.
+func (l *Loader[T]) Wait(d time.Duration) *Loader[T] {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.wait = d
+	return l
+}
+
+// loadMap looks up the objects identified by the provided IDs like LoadMany
+// but retrieves them immediately and returns them keyed by their ID.
+func (l *Loader[T]) loadMap(ids []int) (map[int]*T, error) {
+	results := make([]*loadResult[T], len(ids))
+	for i, id := range ids {
+		results[i] = l.enqueue(id)
+	}
+	l.Flush()
+
+	vals := make(map[int]*T, len(ids))
+	for i, r := range results {
+		v, err := l.await(r)
+		if isEmptyResult(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		vals[ids[i]] = v
+	}
+
+	return vals, nil
+}
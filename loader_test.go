@@ -0,0 +1,247 @@
+package igdb
+
+import (
+	"context"
+	"fmt"
+	"github.com/pkg/errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+var idListRE = regexp.MustCompile(`id = \(([\d,]+)\)`)
+
+// startLoaderServer initializes and returns a test server that responds to List
+// queries with the Games whose IDs are requested and no greater than the provided
+// maximum ID. The provided counter is incremented for each request received and
+// the requested IDs of each request are recorded in the provided slice.
+func startLoaderServer(maxID int, status int, calls *int32, batches *[][]int) (*httptest.Server, *Client) {
+	mu := sync.Mutex{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(calls, 1)
+		if status != http.StatusOK {
+			w.WriteHeader(status)
+			return
+		}
+
+		b, _ := ioutil.ReadAll(r.Body)
+		m := idListRE.FindStringSubmatch(string(b))
+		if m == nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		var ids []int
+		var games []string
+		for _, s := range strings.Split(m[1], ",") {
+			id, _ := strconv.Atoi(s)
+			ids = append(ids, id)
+			if id <= maxID {
+				games = append(games, fmt.Sprintf(`{"id": %d, "name": "game%d"}`, id, id))
+			}
+		}
+
+		mu.Lock()
+		*batches = append(*batches, ids)
+		mu.Unlock()
+
+		fmt.Fprint(w, "["+strings.Join(games, ",")+"]")
+	}))
+
+	return ts, NewClient(testKey, ts.Client(), WithBaseURL(ts.URL))
+}
+
+func TestGameLoader_Load(t *testing.T) {
+	var calls int32
+	var batches [][]int
+	ts, c := startLoaderServer(50, http.StatusOK, &calls, &batches)
+	defer ts.Close()
+
+	l := c.Games.Loader(context.Background(), SetFields("name")).Wait(50 * time.Millisecond)
+
+	ids := []int{1, 2, 3, 2, 99, 4}
+	games := make([]*Game, len(ids))
+	errs := make([]error, len(ids))
+
+	wg := sync.WaitGroup{}
+	for i, id := range ids {
+		wg.Add(1)
+		go func(i, id int) {
+			defer wg.Done()
+			games[i], errs[i] = l.Load(id)
+		}(i, id)
+	}
+	wg.Wait()
+
+	if calls != 1 {
+		t.Errorf("got: <%v> calls, want: <%v>", calls, 1)
+	}
+
+	for i, id := range ids {
+		if id == 99 {
+			if !errors.Is(errs[i], ErrNotFound) {
+				t.Errorf("got: <%v>, want: <%v>", errs[i], ErrNotFound)
+			}
+			continue
+		}
+
+		if errs[i] != nil {
+			t.Fatal(errs[i])
+		}
+
+		if games[i].ID != id || games[i].Name != "game"+strconv.Itoa(id) {
+			t.Errorf("got: <%v>, want: <%v>", games[i].ID, id)
+		}
+	}
+
+	if len(batches) != 1 || len(batches[0]) != 5 {
+		t.Errorf("got: <%v>, want one batch of 5 unique IDs", batches)
+	}
+
+	// Cached lookups, including missing IDs, are not retrieved again.
+	if _, err := l.Load(3); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := l.Load(99); !errors.Is(err, ErrNotFound) {
+		t.Errorf("got: <%v>, want: <%v>", err, ErrNotFound)
+	}
+
+	if calls != 1 {
+		t.Errorf("got: <%v> calls, want: <%v>", calls, 1)
+	}
+}
+
+func TestGameLoader_LoadMany(t *testing.T) {
+	tests := []struct {
+		name        string
+		opts        []Option
+		ids         []int
+		wantIDs     []int
+		wantBatches [][]int
+		wantErr     error
+	}{
+		{"Single batch", nil, []int{5, 3, 1}, []int{5, 3, 1}, [][]int{{5, 3, 1}}, nil},
+		{"Missing IDs ignored", nil, []int{5, 60, 1}, []int{5, 1}, [][]int{{5, 60, 1}}, nil},
+		{"None found", nil, []int{60, 70}, []int{}, [][]int{{60, 70}}, nil},
+		{"Chunked batches", []Option{SetLimit(3)}, []int{1, 2, 3, 4, 5, 6, 7}, []int{1, 2, 3, 4, 5, 6, 7}, [][]int{{1, 2, 3}, {4, 5, 6}, {7}}, nil},
+		{"Duplicate IDs", nil, []int{1, 1, 2}, []int{1, 1, 2}, [][]int{{1, 2}}, nil},
+		{"Empty IDs", nil, []int{}, nil, nil, ErrEmptyIDs},
+		{"Negative ID", nil, []int{1, -1}, nil, nil, ErrNegativeID},
+		{"Invalid option", []Option{SetLimit(-1)}, []int{1}, nil, nil, ErrOutOfRange},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var calls int32
+			var batches [][]int
+			ts, c := startLoaderServer(50, http.StatusOK, &calls, &batches)
+			defer ts.Close()
+
+			games, err := c.Games.Loader(context.Background(), test.opts...).LoadMany(test.ids)
+			if errors.Cause(err) != test.wantErr {
+				t.Fatalf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if err != nil {
+				return
+			}
+
+			ids := []int{}
+			for _, g := range games {
+				ids = append(ids, g.ID)
+			}
+
+			if !reflect.DeepEqual(ids, test.wantIDs) {
+				t.Errorf("got: <%v>, want: <%v>", ids, test.wantIDs)
+			}
+
+			if len(batches) != len(test.wantBatches) {
+				t.Fatalf("got: <%v>, want: <%v>", batches, test.wantBatches)
+			}
+
+			for _, want := range test.wantBatches {
+				found := false
+				for _, b := range batches {
+					found = found || reflect.DeepEqual(b, want)
+				}
+				if !found {
+					t.Errorf("got: <%v>, want batch: <%v>", batches, want)
+				}
+			}
+		})
+	}
+}
+
+func TestGameLoader_Error(t *testing.T) {
+	var calls int32
+	var batches [][]int
+	ts, c := startLoaderServer(50, http.StatusInternalServerError, &calls, &batches)
+	defer ts.Close()
+
+	l := c.Games.Loader(context.Background())
+
+	for i := 1; i <= 2; i++ {
+		if _, err := l.Load(1); errors.Cause(err) != ErrInternalError {
+			t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), ErrInternalError)
+		}
+
+		// Failed lookups are not cached.
+		if calls != int32(i) {
+			t.Errorf("got: <%v> calls, want: <%v>", calls, i)
+		}
+	}
+}
+
+func TestGameLoader_Flush(t *testing.T) {
+	var calls int32
+	var batches [][]int
+	ts, c := startLoaderServer(50, http.StatusOK, &calls, &batches)
+	defer ts.Close()
+
+	l := c.Games.Loader(context.Background()).Wait(time.Hour)
+
+	done := make(chan error)
+	go func() {
+		_, err := l.Load(1)
+		done <- err
+	}()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		l.mu.Lock()
+		queued := l.batch != nil
+		l.mu.Unlock()
+		if queued || time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	l.Flush()
+
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGameLoader_Context(t *testing.T) {
+	var calls int32
+	var batches [][]int
+	ts, c := startLoaderServer(50, http.StatusOK, &calls, &batches)
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := c.Games.Loader(ctx).Wait(time.Hour).Load(1)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("got: <%v>, want: <%v>", err, context.Canceled)
+	}
+}
@@ -0,0 +1,192 @@
+package igdb
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"github.com/Henry-Sarabia/blank"
+	"github.com/pkg/errors"
+)
+
+// MaxMultiQueries is the maximum number of queries the IGDB accepts in a single multiquery.
+const MaxMultiQueries int = 10
+
+// Errors returned when building or running a MultiQuery.
+var (
+	// ErrEmptyMultiQuery occurs when a MultiQuery is run without any queries.
+	ErrEmptyMultiQuery = errors.New("multiquery has no queries")
+	// ErrTooManyQueries occurs when more than MaxMultiQueries queries are added to a MultiQuery.
+	ErrTooManyQueries = errors.New("multiquery has too many queries")
+	// ErrInvalidQueryName occurs when a query name is empty, contains a double quote, or is
+	// used by more than one query of a MultiQuery.
+	ErrInvalidQueryName = errors.New("multiquery query name is empty, contains a double quote, or is already used")
+	// ErrInvalidResult occurs when the result of a query is not a non-nil pointer.
+	ErrInvalidResult = errors.New("multiquery result must be a non-nil pointer")
+)
+
+// MultiQuery batches named queries against different endpoints into a single
+// API call to the IGDB multiquery endpoint. Each query decodes its results into
+// its own value (e.g. a *[]*Game or a *[]*Cover), while count queries decode
+// the number of matching objects into an *int.
+//
+// A MultiQuery is created with Client.MultiQuery, built with Add and Count,
+// and sent with Run.
+//
+// For more information visit: https://api-docs.igdb.com/#multi-query
+type MultiQuery struct {
+	client  *Client
+	queries []*subQuery
+	err     error
+}
+
+// subQuery is a single named query of a MultiQuery.
+type subQuery struct {
+	name   string
+	end    endpoint
+	count  bool
+	result interface{}
+	opts   []Option
+}
+
+// multiResult is the result of a single named query returned by the IGDB.
+type multiResult struct {
+	Name   string          `json:"name"`
+	Result json.RawMessage `json:"result"`
+	Count  int             `json:"count"`
+}
+
+// MultiQuery returns a new, empty MultiQuery that is sent using the Client.
+func (c *Client) MultiQuery() *MultiQuery {
+	return &MultiQuery{client: c}
+}
+
+// Add adds a query with the provided name against the provided endpoint
+// (e.g. EndpointGame) to the MultiQuery. Provide functional options to
+// sort, filter, and paginate the results. Once the MultiQuery is run, the
+// results are stored in the value pointed to by result (e.g. a *[]*Game).
+// Query names must be unique within a MultiQuery. Any error is returned
+// when the MultiQuery is run.
+func (mq *MultiQuery) Add(name string, end endpoint, result interface{}, opts ...Option) *MultiQuery {
+	return mq.add(&subQuery{name: name, end: end, result: result, opts: opts})
+}
+
+// Count adds a count query with the provided name against the provided
+// endpoint (e.g. EndpointGame) to the MultiQuery. Provide the SetFilter
+// functional option if you need to filter what to count. Once the MultiQuery
+// is run, the count is stored in the int pointed to by count. Query names
+// must be unique within a MultiQuery. Any error is returned when the
+// MultiQuery is run.
+func (mq *MultiQuery) Count(name string, end endpoint, count *int, opts ...Option) *MultiQuery {
+	return mq.add(&subQuery{name: name, end: end, count: true, result: count, opts: opts})
+}
+
+// add validates and adds the provided query to the MultiQuery. Only the
+// first error encountered is kept.
+func (mq *MultiQuery) add(q *subQuery) *MultiQuery {
+	if mq.err != nil {
+		return mq
+	}
+
+	if blank.Is(q.name) || strings.Contains(q.name, `"`) || mq.query(q.name) != nil {
+		mq.err = errors.Wrapf(ErrInvalidQueryName, "cannot add query %q", q.name)
+		return mq
+	}
+
+	if v := reflect.ValueOf(q.result); v.Kind() != reflect.Ptr || v.IsNil() {
+		mq.err = errors.Wrapf(ErrInvalidResult, "cannot add query %q", q.name)
+		return mq
+	}
+
+	if len(mq.queries) >= MaxMultiQueries {
+		mq.err = errors.Wrapf(ErrTooManyQueries, "cannot add more than %d queries", MaxMultiQueries)
+		return mq
+	}
+
+	mq.queries = append(mq.queries, q)
+	return mq
+}
+
+// query returns the query with the provided name, if any.
+func (mq *MultiQuery) query(name string) *subQuery {
+	for _, q := range mq.queries {
+		if q.name == name {
+			return q
+		}
+	}
+
+	return nil
+}
+
+// render renders every query of the MultiQuery into a single multiquery body.
+func (mq *MultiQuery) render() (string, error) {
+	b := strings.Builder{}
+	for _, q := range mq.queries {
+		qry, err := renderQuery(q.opts...)
+		if err != nil {
+			return "", errors.Wrapf(err, "cannot render query %q", q.name)
+		}
+
+		end := strings.TrimSuffix(string(q.end), "/")
+		if q.count {
+			end += "/count"
+		}
+
+		b.WriteString("query " + end + ` "` + q.name + `" {` + qry + "};\n")
+	}
+
+	return b.String(), nil
+}
+
+// Run sends every query of the MultiQuery in a single API call and stores
+// each set of results in the value provided for its query. A query that
+// does not match anything leaves its value empty rather than returning an
+// error.
+func (mq *MultiQuery) Run(ctx context.Context) error {
+	if mq.err != nil {
+		return mq.err
+	}
+
+	if len(mq.queries) <= 0 {
+		return ErrEmptyMultiQuery
+	}
+
+	body, err := mq.render()
+	if err != nil {
+		return err
+	}
+
+	req, err := mq.client.requestBody(ctx, EndpointMultiQuery, body)
+	if err != nil {
+		return err
+	}
+
+	var res []multiResult
+
+	if err := mq.client.send(req, &res); err != nil && !isEmptyResult(err) {
+		return errors.Wrap(err, "cannot run multiquery")
+	}
+
+	for _, r := range res {
+		q := mq.query(r.Name)
+		if q == nil {
+			continue
+		}
+
+		if q.count {
+			*q.result.(*int) = r.Count
+			continue
+		}
+
+		if len(r.Result) <= 0 {
+			continue
+		}
+
+		if err := json.Unmarshal(r.Result, q.result); err != nil {
+			return errors.Wrapf(ErrInvalidJSON, "cannot decode results of query %q: %s", q.name, err.Error())
+		}
+	}
+
+	return nil
+}
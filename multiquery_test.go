@@ -0,0 +1,213 @@
+package igdb
+
+import (
+	"context"
+	"fmt"
+	"github.com/pkg/errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const testMultiQueryResult = `[
+	{"name": "Game", "result": [{"id": 1942, "name": "The Witcher 3: Wild Hunt"}]},
+	{"name": "Covers", "result": [{"id": 89386, "image_id": "co1wyy"}, {"id": 89387, "image_id": "co1wyz"}]},
+	{"name": "PS4 Games", "count": 5412},
+	{"name": "Screenshots", "result": []}
+]`
+
+func TestMultiQuery_Run(t *testing.T) {
+	var qry, path string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		qry, path = string(b), r.URL.Path
+		fmt.Fprint(w, testMultiQueryResult)
+	}))
+	defer ts.Close()
+
+	c := NewClient(testKey, ts.Client(), WithBaseURL(ts.URL))
+
+	var (
+		games   []*Game
+		covers  []*Cover
+		shots   []*Screenshot
+		ps4, pc int
+	)
+
+	err := c.MultiQuery().
+		Add("Game", EndpointGame, &games, SetFields("name"), SetFilter("id", OpEquals, "1942")).
+		Add("Covers", EndpointCover, &covers, SetFields("image_id"), SetLimit(2)).
+		Count("PS4 Games", EndpointGame, &ps4, SetFilter("platforms", OpEquals, "48")).
+		Count("PC Games", EndpointGame, &pc, SetFilter("platforms", OpEquals, "6")).
+		Add("Screenshots", EndpointScreenshot, &shots).
+		Run(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if path != "/multiquery" {
+		t.Errorf("got: <%v>, want: <%v>", path, "/multiquery")
+	}
+
+	want := "query games \"Game\" {fields name; where id = 1942; };\n" +
+		"query covers \"Covers\" {fields image_id; limit 2; };\n" +
+		"query games/count \"PS4 Games\" {where platforms = 48; };\n" +
+		"query games/count \"PC Games\" {where platforms = 6; };\n" +
+		"query screenshots \"Screenshots\" {};\n"
+	if qry != want {
+		t.Errorf("got: <%v>, want: <%v>", qry, want)
+	}
+
+	if len(games) != 1 || games[0].ID != 1942 || games[0].Name != "The Witcher 3: Wild Hunt" {
+		t.Errorf("got: <%v>, want: <%v>", games, "The Witcher 3: Wild Hunt")
+	}
+
+	if len(covers) != 2 || covers[1].ImageID != "co1wyz" {
+		t.Errorf("got: <%v>, want 2 covers", covers)
+	}
+
+	if ps4 != 5412 {
+		t.Errorf("got: <%v>, want: <%v>", ps4, 5412)
+	}
+
+	if pc != 0 {
+		t.Errorf("got: <%v>, want: <%v>", pc, 0)
+	}
+
+	if len(shots) != 0 {
+		t.Errorf("got: <%v>, want: <%v>", len(shots), 0)
+	}
+}
+
+func TestMultiQuery_Errors(t *testing.T) {
+	var games []*Game
+	var count int
+
+	tests := []struct {
+		name    string
+		status  int
+		resp    string
+		build   func(mq *MultiQuery) *MultiQuery
+		wantErr error
+	}{
+		{"Empty multiquery", http.StatusOK, "[]", func(mq *MultiQuery) *MultiQuery { return mq }, ErrEmptyMultiQuery},
+		{
+			"Blank name",
+			http.StatusOK,
+			"[]",
+			func(mq *MultiQuery) *MultiQuery { return mq.Add(" ", EndpointGame, &games) },
+			ErrInvalidQueryName,
+		},
+		{
+			"Quoted name",
+			http.StatusOK,
+			"[]",
+			func(mq *MultiQuery) *MultiQuery { return mq.Add(`"Games"`, EndpointGame, &games) },
+			ErrInvalidQueryName,
+		},
+		{
+			"Duplicate name",
+			http.StatusOK,
+			"[]",
+			func(mq *MultiQuery) *MultiQuery {
+				return mq.Add("Games", EndpointGame, &games).Count("Games", EndpointGame, &count)
+			},
+			ErrInvalidQueryName,
+		},
+		{
+			"Non-pointer result",
+			http.StatusOK,
+			"[]",
+			func(mq *MultiQuery) *MultiQuery { return mq.Add("Games", EndpointGame, games) },
+			ErrInvalidResult,
+		},
+		{
+			"Nil count",
+			http.StatusOK,
+			"[]",
+			func(mq *MultiQuery) *MultiQuery { return mq.Count("Games", EndpointGame, nil) },
+			ErrInvalidResult,
+		},
+		{
+			"Too many queries",
+			http.StatusOK,
+			"[]",
+			func(mq *MultiQuery) *MultiQuery {
+				for i := 0; i <= MaxMultiQueries; i++ {
+					mq.Count(fmt.Sprint(i), EndpointGame, &count)
+				}
+				return mq
+			},
+			ErrTooManyQueries,
+		},
+		{
+			"Invalid option",
+			http.StatusOK,
+			"[]",
+			func(mq *MultiQuery) *MultiQuery { return mq.Add("Games", EndpointGame, &games, SetLimit(-1)) },
+			ErrOutOfRange,
+		},
+		{
+			"Status bad request",
+			http.StatusBadRequest,
+			"[]",
+			func(mq *MultiQuery) *MultiQuery { return mq.Add("Games", EndpointGame, &games) },
+			ErrBadRequest,
+		},
+		{
+			"Invalid JSON",
+			http.StatusOK,
+			"[{",
+			func(mq *MultiQuery) *MultiQuery { return mq.Add("Games", EndpointGame, &games) },
+			ErrInvalidJSON,
+		},
+		{
+			"Mismatched result type",
+			http.StatusOK,
+			`[{"name": "Games", "result": {"id": 1}}]`,
+			func(mq *MultiQuery) *MultiQuery { return mq.Add("Games", EndpointGame, &games) },
+			ErrInvalidJSON,
+		},
+		{
+			"Empty response",
+			http.StatusOK,
+			"[]",
+			func(mq *MultiQuery) *MultiQuery { return mq.Add("Games", EndpointGame, &games) },
+			nil,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerString(test.status, test.resp)
+			defer ts.Close()
+
+			err := test.build(c.MultiQuery()).Run(context.Background())
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+		})
+	}
+}
+
+func ExampleClient_MultiQuery() {
+	c := NewTwitchClient("YOUR_CLIENT_ID", "YOUR_CLIENT_SECRET", nil)
+
+	var (
+		games  []*Game
+		covers []*Cover
+		total  int
+	)
+
+	err := c.MultiQuery().
+		Add("Game", EndpointGame, &games, SetFields("name"), SetFilter("id", OpEquals, "1942")).
+		Add("Cover", EndpointCover, &covers, SetFields("image_id"), SetFilter("game", OpEquals, "1942")).
+		Count("PS4 Games", EndpointGame, &total, SetFilter("platforms", OpEquals, "48")).
+		Run(context.Background())
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(games[0].Name, covers[0].ImageID, total)
+}
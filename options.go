@@ -2,6 +2,7 @@ package igdb
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/Henry-Sarabia/apicalypse"
@@ -16,6 +17,8 @@ var (
 	// ErrEmptyFields occurs when an empty string is used as a field value.
 	ErrEmptyFields = errors.New("one or more provided option field values are empty")
 	// ErrExpandedField occurs when a field value tries to access an expanded subfield.
+	//
+	// Deprecated: Expanded subfields are supported and ErrExpandedField is no longer returned.
 	ErrExpandedField = errors.New("one or more provided option field values is an expanded subfield which is not supported")
 	// ErrEmptyFilterVals occurs when an empty string is used as a filter value.
 	ErrEmptyFilterVals = errors.New("one or more provided filter option values are empty")
@@ -58,6 +61,74 @@ func unwrapOptions(opts ...Option) ([]apicalypse.Option, error) {
 	return unwrapped, nil
 }
 
+// queryOrder is the order in which the clauses of a query are rendered.
+// Rendering clauses in a fixed order means identical options always
+// produce an identical query.
+var queryOrder = []string{"fields", "exclude", "search", "where", "sort", "limit", "offset"}
+
+// renderQuery executes the provided options and renders them into an
+// Apicalypse query (e.g. "fields name; where id = 1; limit 5; ").
+func renderQuery(opts ...Option) (string, error) {
+	clauses, err := applyOptions(opts...)
+	if err != nil {
+		return "", err
+	}
+
+	return renderClauses(clauses), nil
+}
+
+// RenderQuery executes the provided options and returns the Apicalypse query
+// they render into, as it would be sent to the IGDB. This is primarily used to
+// inspect the options provided to a mock, such as those of the igdbmock package.
+func RenderQuery(opts ...Option) (string, error) {
+	return renderQuery(opts...)
+}
+
+// renderClauses renders the provided query clauses into an Apicalypse query.
+// Clauses that only carry information for the Client, such as its cache mode,
+// are not rendered.
+func renderClauses(clauses map[string]string) string {
+	b := strings.Builder{}
+	for _, k := range queryOrder {
+		if v, ok := clauses[k]; ok {
+			b.WriteString(k + " " + v + "; ")
+			delete(clauses, k)
+		}
+	}
+
+	rest := make([]string, 0, len(clauses))
+	for k := range clauses {
+		if !strings.HasPrefix(k, metaPrefix) {
+			rest = append(rest, k)
+		}
+	}
+	sort.Strings(rest)
+
+	for _, k := range rest {
+		b.WriteString(k + " " + clauses[k] + "; ")
+	}
+
+	return b.String()
+}
+
+// applyOptions executes the provided options and returns the resulting
+// query clauses keyed by their name (e.g. "fields" or "limit").
+func applyOptions(opts ...Option) (map[string]string, error) {
+	unwrapped, err := unwrapOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	clauses := map[string]string{}
+	for _, opt := range unwrapped {
+		if err := opt(clauses); err != nil {
+			return nil, errors.Wrap(err, "cannot apply invalid option")
+		}
+	}
+
+	return clauses, nil
+}
+
 // order specifies the order in which to organize the results from an API call.
 // There are three orders in which results are organized: relevance, ascending,
 // and descending. Relevance is only available as a default and cannot be
@@ -123,6 +194,10 @@ func SetOffset(off int) Option {
 // match an IGDB object's JSON field tag exactly, not the Go struct field
 // name.
 //
+// Accessing a subfield expands the referenced object so that it is returned
+// in place of its ID. Expanded objects are decoded with Reference.Decode and
+// References.Decode.
+//
 // For more information, visit: https://api-docs.igdb.com/#fields
 func SetFields(fields ...string) Option {
 	return func() (apicalypse.Option, error) {
@@ -134,10 +209,6 @@ func SetFields(fields ...string) Option {
 			if blank.Is(f) {
 				return nil, ErrEmptyFields
 			}
-
-			if strings.Contains(f, ".") {
-				return nil, ErrExpandedField
-			}
 		}
 
 		return apicalypse.Fields(fields...), nil
@@ -145,9 +216,10 @@ func SetFields(fields ...string) Option {
 }
 
 // SetExclude is a functional option used to specify which fields of the
-// requested IGDB object you want the API to exclude. Note that the field
-// string must match an IGDB object's JSON field tag exactly, not the Go struct
-// name.
+// requested IGDB object you want the API to exclude. Subfields of expanded
+// objects are excluded with a dot operator (e.g. cover.url). Note that the
+// field string must match an IGDB object's JSON field tag exactly, not the
+// Go struct name.
 //
 // For more information, visit: https://api-docs.igdb.com/#exclude
 func SetExclude(fields ...string) Option {
@@ -160,10 +232,6 @@ func SetExclude(fields ...string) Option {
 			if blank.Is(f) {
 				return nil, ErrEmptyFields
 			}
-
-			if strings.Contains(f, ".") {
-				return nil, ErrExpandedField
-			}
 		}
 
 		return apicalypse.Exclude(fields...), nil
@@ -112,6 +112,40 @@ func TestUnwrapOptions(t *testing.T) {
 	}
 }
 
+func TestRenderQuery(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    []Option
+		wantQry string
+		wantErr error
+	}{
+		{"Zero options", nil, "", nil},
+		{"Single option", []Option{SetLimit(10)}, "limit 10; ", nil},
+		{
+			"Multiple options",
+			[]Option{SetOffset(20), SetLimit(10), SetOrder("rating", OrderDescending), SetFilter("rating", OpGreaterThan, "80"), SetFields("name", "rating")},
+			"fields name,rating; where rating > 80; sort rating desc; limit 10; offset 20; ",
+			nil,
+		},
+		{"Search option", []Option{SetFields("name"), setSearch("zelda")}, `fields name; search "zelda"; `, nil},
+		{"Invalid option", []Option{SetLimit(10), SetOffset(-99999)}, "", ErrOutOfRange},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			for i := 0; i < 5; i++ {
+				qry, err := renderQuery(test.opts...)
+				if errors.Cause(err) != test.wantErr {
+					t.Fatalf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+				}
+
+				if qry != test.wantQry {
+					t.Errorf("got: <%v>, want: <%v>", qry, test.wantQry)
+				}
+			}
+		})
+	}
+}
+
 func TestSetOrder(t *testing.T) {
 	var tests = []struct {
 		name    string
@@ -233,8 +267,8 @@ func TestSetFields(t *testing.T) {
 		{"Single empty field", []string{"  "}, "", ErrEmptyFields},
 		{"Multiple empty fields", []string{"", " ", "", ""}, "", ErrEmptyFields},
 		{"Mixed empty and non-empty fields", []string{"", "id", "  ", "url"}, "", ErrEmptyFields},
-		{"Single expanded field", []string{"game.name"}, "", ErrExpandedField},
-		{"Multiple expanded fields", []string{"game.name", "game.id"}, "", ErrExpandedField},
+		{"Single expanded field", []string{"game.name"}, "game.name", nil},
+		{"Multiple expanded fields", []string{"game.name", "game.id"}, "game.name,game.id", nil},
 	}
 
 	for _, test := range tests {
@@ -273,8 +307,8 @@ func TestSetExclude(t *testing.T) {
 		{"Single empty field", []string{"  "}, "", ErrEmptyFields},
 		{"Multiple empty fields", []string{"", " ", "", ""}, "", ErrEmptyFields},
 		{"Mixed empty and non-empty fields", []string{"", "id", "  ", "url"}, "", ErrEmptyFields},
-		{"Single expanded field", []string{"game.name"}, "", ErrExpandedField},
-		{"Multiple expanded fields", []string{"game.name", "game.id"}, "", ErrExpandedField},
+		{"Single expanded field", []string{"game.name"}, "game.name", nil},
+		{"Multiple expanded fields", []string{"game.name", "game.id"}, "game.name,game.id", nil},
 	}
 
 	for _, test := range tests {
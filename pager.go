@@ -0,0 +1,338 @@
+package igdb
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// ErrIterateOffset occurs when an iterator reaches the maximum offset of the IGDB.
+// Use Scan instead to read every result of an endpoint.
+var ErrIterateOffset = errors.New("cannot iterate past the maximum offset: use Scan to read every result")
+
+// Errors returned when scanning an endpoint by ID.
+var (
+	// ErrScanOffset occurs when a scan is given an offset. Scans resume from a cursor instead.
+	ErrScanOffset = errors.New("cannot scan with an offset: provide a cursor instead")
+	// ErrScanOrder occurs when a scan is given an order. Scans are always sorted by ID.
+	ErrScanOrder = errors.New("cannot scan with an order: results are sorted by ID")
+	// ErrScanStalled occurs when a page of scanned results does not advance the cursor,
+	// such as when the ID field is excluded from the results.
+	ErrScanStalled = errors.New("scan cursor did not advance: check that the ID field is retrieved")
+)
+
+// DefaultPageSize is the number of results an iterator retrieves per API call
+// when no limit is provided with the SetLimit functional option. It is the
+// maximum number of results the IGDB returns in a single API call.
+const DefaultPageSize int = 500
+
+// offsetLimit is the maximum offset the IGDB accepts.
+const offsetLimit int = 5000
+
+// pageFunc retrieves a single page of results using the provided options.
+type pageFunc[T any] func(ctx context.Context, opts ...Option) ([]*T, error)
+
+// searchFunc retrieves a single page of the results found by searching the
+// IGDB using the provided query and options.
+type searchFunc[T any] func(ctx context.Context, qry string, opts ...Option) ([]*T, error)
+
+// countFunc counts the results matching the provided options.
+type countFunc func(ctx context.Context, opts ...Option) (int, error)
+
+// idFunc returns the ID of the provided result.
+type idFunc[T any] func(v *T) int
+
+// page is a page of results retrieved by a pager.
+type page[T any] struct {
+	results []*T
+	err     error
+}
+
+// pager is the core shared by the iterators of every service. It retrieves
+// pages of results lazily by advancing the offset of the query one page at a
+// time, optionally prefetching the next page while the current one is read.
+// A pager stops once the IGDB returns an empty or partial page.
+//
+// A pager with an ID function pages by ID instead of by offset: results are
+// sorted by ID and each page is filtered to the IDs following the last ID of
+// the previous page. This is not limited by the maximum offset of the IGDB.
+type pager[T any] struct {
+	ctx      context.Context
+	fetch    pageFunc[T]
+	count    countFunc
+	id       idFunc[T]
+	opts     []Option
+	size     int
+	offset   int
+	cursor   int
+	prefetch bool
+	pending  chan page[T]
+	done     bool
+	err      error
+}
+
+// newPager returns a pager that retrieves pages of results using the provided
+// page and count functions. The page size and starting offset are taken from
+// the SetLimit and SetOffset functional options, if provided.
+func newPager[T any](ctx context.Context, fetch pageFunc[T], count countFunc, opts []Option) *pager[T] {
+	p := &pager[T]{
+		ctx:   ctx,
+		fetch: fetch,
+		count: count,
+		opts:  opts,
+		size:  DefaultPageSize,
+	}
+
+	clauses, err := applyOptions(opts...)
+	if err != nil {
+		p.err = errors.Wrap(err, "cannot iterate with invalid options")
+		p.done = true
+		return p
+	}
+
+	if lim, ok := clauses["limit"]; ok {
+		p.size, _ = strconv.Atoi(lim)
+	}
+
+	if off, ok := clauses["offset"]; ok {
+		p.offset, _ = strconv.Atoi(off)
+	}
+
+	return p
+}
+
+// newSearchPager returns a pager that retrieves pages of the results found by
+// searching the IGDB using the provided query, with the provided search and
+// count functions.
+func newSearchPager[T any](ctx context.Context, search searchFunc[T], count countFunc, qry string, opts []Option) *pager[T] {
+	fetch := func(ctx context.Context, opts ...Option) ([]*T, error) {
+		return search(ctx, qry, opts...)
+	}
+
+	countSearch := func(ctx context.Context, opts ...Option) (int, error) {
+		return count(ctx, append(opts, setSearch(qry))...)
+	}
+
+	return newPager(ctx, fetch, countSearch, opts)
+}
+
+// newScanner returns a pager that retrieves pages of results by ID, starting
+// after the provided cursor, using the provided page, count, and ID
+// functions. The page size is taken from the SetLimit functional option, if
+// provided. The SetOffset and SetOrder functional options are not allowed.
+func newScanner[T any](ctx context.Context, fetch pageFunc[T], count countFunc, id idFunc[T], cursor int, opts []Option) *pager[T] {
+	p := newPager(ctx, fetch, count, opts)
+	p.id = id
+	p.cursor = cursor
+
+	if p.done {
+		return p
+	}
+
+	clauses, _ := applyOptions(opts...)
+	if _, ok := clauses["offset"]; ok {
+		p.err, p.done = ErrScanOffset, true
+	}
+	if _, ok := clauses["sort"]; ok {
+		p.err, p.done = ErrScanOrder, true
+	}
+
+	if cursor < 0 {
+		p.err, p.done = ErrNegativeID, true
+	}
+
+	if cursor > 0 {
+		p.count = func(ctx context.Context, opts ...Option) (int, error) {
+			return count(ctx, append(opts, SetWhere(Gt("id", cursor)))...)
+		}
+	}
+
+	return p
+}
+
+// next returns the next page of results. False is returned once every page
+// has been retrieved or an error occurs. An empty result ends the iteration
+// without an error.
+func (p *pager[T]) next() ([]*T, bool) {
+	if p.done {
+		return nil, false
+	}
+
+	var pg page[T]
+	if p.pending != nil {
+		pg = <-p.pending
+		p.pending = nil
+	} else {
+		pg = p.get(p.offset, p.cursor)
+	}
+
+	if pg.err != nil {
+		p.done = true
+		if !isEmptyResult(pg.err) {
+			p.err = pg.err
+		}
+		return nil, false
+	}
+
+	if len(pg.results) == 0 {
+		p.done = true
+		return nil, false
+	}
+
+	p.offset += p.size
+	if p.id != nil {
+		last := p.id(pg.results[len(pg.results)-1])
+		if last <= p.cursor {
+			p.err, p.done = errors.Wrapf(ErrScanStalled, "cannot advance past ID %d", p.cursor), true
+			return nil, false
+		}
+		p.cursor = last
+	}
+
+	if len(pg.results) < p.size {
+		p.done = true
+	} else if p.prefetch {
+		ch := make(chan page[T], 1)
+		go func(off, cursor int) {
+			ch <- p.get(off, cursor)
+		}(p.offset, p.cursor)
+		p.pending = ch
+	}
+
+	return pg.results, true
+}
+
+// get retrieves the page of results found at the provided offset or, if the
+// pager pages by ID, the page of results following the provided cursor.
+func (p *pager[T]) get(off, cursor int) page[T] {
+	if err := p.ctx.Err(); err != nil {
+		return page[T]{err: err}
+	}
+
+	opts := append(p.opts[:len(p.opts):len(p.opts)], SetLimit(p.size))
+	switch {
+	case p.id != nil:
+		opts = append(opts, SetOrder("id", OrderAscending), SetWhere(Gt("id", cursor)))
+	case off > offsetLimit:
+		return page[T]{err: errors.Wrapf(ErrIterateOffset, "cannot retrieve page at offset %d", off)}
+	default:
+		opts = append(opts, SetOffset(off))
+	}
+
+	results, err := p.fetch(p.ctx, opts...)
+	if err != nil {
+		if p.id != nil {
+			return page[T]{err: errors.Wrapf(err, "cannot retrieve page after ID %d", cursor)}
+		}
+		return page[T]{err: errors.Wrapf(err, "cannot retrieve page at offset %d", off)}
+	}
+
+	return page[T]{results: results}
+}
+
+// total returns the total number of results the pager iterates over, counted
+// by the IGDB using the same options as the pager.
+func (p *pager[T]) total() (int, error) {
+	return p.count(p.ctx, p.opts...)
+}
+
+// Iterate returns an iterator over the index of objects based solely on the
+// provided functional options used to sort and filter the results. The
+// SetLimit and SetOffset functional options set the page size and the
+// starting offset of the iterator. The provided context is used for every
+// page retrieved. Iterate cannot read past the maximum offset of the IGDB and
+// stops with ErrIterateOffset once it is reached. Use Scan instead to read
+// every object of a large endpoint.
+func (s *Service[T]) Iterate(ctx context.Context, opts ...Option) *Iterator[T] {
+	return s.iterator(newPager(ctx, s.IndexContext, s.CountContext, opts))
+}
+
+// Scan returns an iterator over every object matching the provided functional
+// options, sorted by ID. Unlike Iterate, Scan pages through the results by
+// ID instead of by offset, so it can read past the maximum offset of the
+// IGDB. The iterator starts after the provided cursor, which is either 0 or
+// the Cursor of a previous iterator, so that an interrupted scan can be
+// resumed. The SetLimit functional option sets the page size, while the
+// SetOffset and SetOrder functional options are not allowed.
+func (s *Service[T]) Scan(ctx context.Context, cursor int, opts ...Option) *Iterator[T] {
+	return s.iterator(newScanner(ctx, s.IndexContext, s.CountContext, s.id, cursor, opts))
+}
+
+// IterateSearch returns an iterator over the objects found by searching the
+// IGDB using the provided query. The SetLimit and SetOffset functional options
+// set the page size and the starting offset of the iterator. The provided
+// context is used for every page retrieved. Like Iterate, IterateSearch stops
+// with ErrIterateOffset once it reaches the maximum offset of the IGDB.
+func (s *SearchableService[T]) IterateSearch(ctx context.Context, qry string, opts ...Option) *Iterator[T] {
+	return s.iterator(newSearchPager(ctx, s.SearchContext, s.CountContext, qry, opts))
+}
+
+// iterator returns an Iterator over the objects retrieved by the provided pager.
+func (s *Service[T]) iterator(p *pager[T]) *Iterator[T] {
+	return &Iterator[T]{pager: p, id: s.id, plural: s.plural, cursor: p.cursor}
+}
+
+// Iterator iterates over objects retrieved from the IGDB one page at a time.
+// Pages are only retrieved as they are needed. Call Next to advance the
+// iterator and Value to retrieve the current object.
+type Iterator[T any] struct {
+	pager  *pager[T]
+	id     idFunc[T]
+	plural string
+	page   []*T
+	cur    *T
+	cursor int
+}
+
+// Next advances the iterator to the next object, retrieving the next page of
+// results when needed. Next returns false once every object has been iterated
+// over or an error occurs. Check Err to tell the two apart.
+func (it *Iterator[T]) Next() bool {
+	for len(it.page) <= 0 {
+		p, ok := it.pager.next()
+		if !ok {
+			it.cur = nil
+			return false
+		}
+		it.page = p
+	}
+
+	it.cur, it.page = it.page[0], it.page[1:]
+	it.cursor = it.id(it.cur)
+	return true
+}
+
+// Value returns the current object.
+func (it *Iterator[T]) Value() *T {
+	return it.cur
+}
+
+// Cursor returns the ID of the last object the iterator advanced to. Save the
+// Cursor to resume a scan from the same position with Scan.
+func (it *Iterator[T]) Cursor() int {
+	return it.cursor
+}
+
+// Err returns the first error encountered while iterating, if any.
+func (it *Iterator[T]) Err() error {
+	return it.pager.err
+}
+
+// Prefetch makes the iterator retrieve the next page of results in the
+// background while the current page is iterated over. Prefetch should be
+// called before the first call to Next.
+func (it *Iterator[T]) Prefetch() *Iterator[T] {
+	it.pager.prefetch = true
+	return it
+}
+
+// Count returns the total number of objects the iterator iterates over.
+func (it *Iterator[T]) Count() (int, error) {
+	ct, err := it.pager.total()
+	if err != nil {
+		return 0, errors.Wrapf(err, "cannot count %s", it.plural)
+	}
+
+	return ct, nil
+}
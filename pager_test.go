@@ -0,0 +1,394 @@
+package igdb
+
+import (
+	"context"
+	"fmt"
+	"github.com/pkg/errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+var (
+	limitRE  = regexp.MustCompile(`limit (\d+);`)
+	offsetRE = regexp.MustCompile(`offset (\d+);`)
+	afterRE  = regexp.MustCompile(`id > (\d+)`)
+)
+
+// startPagingServer initializes and returns a test server that serves the
+// provided number of Games with sequential IDs, paginated according to the
+// limit and offset of each query, or to the IDs following the "id > N" filter of
+// each query. Count queries return the number of Games.
+// The provided counter is incremented for each page served.
+func startPagingServer(total int, pages *int32) (*httptest.Server, *Client) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		qry := string(b)
+
+		if strings.HasSuffix(r.URL.Path, "count") {
+			fmt.Fprintf(w, `{"count": %d}`, total)
+			return
+		}
+
+		atomic.AddInt32(pages, 1)
+
+		lim, off := 10, 0
+		if m := limitRE.FindStringSubmatch(qry); m != nil {
+			lim, _ = strconv.Atoi(m[1])
+		}
+		if m := offsetRE.FindStringSubmatch(qry); m != nil {
+			off, _ = strconv.Atoi(m[1])
+		}
+
+		if m := afterRE.FindStringSubmatch(qry); m != nil {
+			off, _ = strconv.Atoi(m[1])
+		}
+
+		ids := []string{}
+		for id := off + 1; id <= total && id <= off+lim; id++ {
+			ids = append(ids, fmt.Sprintf(`{"id": %d}`, id))
+		}
+
+		fmt.Fprint(w, "["+strings.Join(ids, ",")+"]")
+	}))
+
+	return ts, NewClient(testKey, ts.Client(), WithBaseURL(ts.URL))
+}
+
+func TestGameIterator(t *testing.T) {
+	tests := []struct {
+		name      string
+		total     int
+		opts      []Option
+		prefetch  bool
+		wantFirst int
+		wantN     int
+		wantPages int32
+		wantErr   error
+	}{
+		{"Single partial page", 5, []Option{SetLimit(10)}, false, 1, 5, 1, nil},
+		{"Several pages", 25, []Option{SetLimit(10)}, false, 1, 25, 3, nil},
+		{"Exact pages", 20, []Option{SetLimit(10)}, false, 1, 20, 3, nil},
+		{"Default page size", 750, nil, false, 1, 750, 2, nil},
+		{"Starting offset", 25, []Option{SetLimit(10), SetOffset(5)}, false, 6, 20, 3, nil},
+		{"No results", 0, []Option{SetLimit(10)}, false, 0, 0, 1, nil},
+		{"Prefetch", 25, []Option{SetLimit(10)}, true, 1, 25, 3, nil},
+		{"Prefetch exact pages", 20, []Option{SetLimit(10)}, true, 1, 20, 3, nil},
+		{"Maximum offset", 6000, []Option{SetLimit(500)}, false, 1, 5500, 11, ErrIterateOffset},
+		{"Prefetch maximum offset", 6000, []Option{SetLimit(500)}, true, 1, 5500, 11, ErrIterateOffset},
+		{"Invalid option", 25, []Option{SetLimit(-1)}, false, 0, 0, 0, ErrOutOfRange},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var pages int32
+			ts, c := startPagingServer(test.total, &pages)
+			defer ts.Close()
+
+			it := c.Games.Iterate(context.Background(), test.opts...)
+			if test.prefetch {
+				it.Prefetch()
+			}
+
+			n, first, last := 0, 0, 0
+			for it.Next() {
+				n++
+				if first == 0 {
+					first = it.Value().ID
+				}
+				if it.Value().ID <= last {
+					t.Fatalf("got: <%v> after <%v>, want increasing IDs", it.Value().ID, last)
+				}
+				last = it.Value().ID
+			}
+
+			if errors.Cause(it.Err()) != test.wantErr {
+				t.Fatalf("got: <%v>, want: <%v>", errors.Cause(it.Err()), test.wantErr)
+			}
+
+			if it.Value() != nil {
+				t.Errorf("got: <%v>, want: <nil>", it.Value())
+			}
+
+			if n != test.wantN {
+				t.Errorf("got: <%v> Games, want: <%v>", n, test.wantN)
+			}
+
+			if first != test.wantFirst {
+				t.Errorf("got: <%v> first ID, want: <%v>", first, test.wantFirst)
+			}
+
+			if pages != test.wantPages {
+				t.Errorf("got: <%v> pages, want: <%v>", pages, test.wantPages)
+			}
+		})
+	}
+}
+
+func TestGameIterator_Lazy(t *testing.T) {
+	var pages int32
+	ts, c := startPagingServer(100, &pages)
+	defer ts.Close()
+
+	it := c.Games.Iterate(context.Background(), SetLimit(10))
+	if pages != 0 {
+		t.Fatalf("got: <%v> pages before Next, want: <%v>", pages, 0)
+	}
+
+	for i := 0; i < 15; i++ {
+		if !it.Next() {
+			t.Fatal(it.Err())
+		}
+	}
+
+	if pages != 2 {
+		t.Errorf("got: <%v> pages, want: <%v>", pages, 2)
+	}
+}
+
+func TestGameIterator_Context(t *testing.T) {
+	var pages int32
+	ts, c := startPagingServer(100, &pages)
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	it := c.Games.Iterate(ctx, SetLimit(10))
+
+	n := 0
+	for it.Next() {
+		n++
+		if n == 10 {
+			cancel()
+		}
+	}
+
+	if !errors.Is(it.Err(), context.Canceled) {
+		t.Errorf("got: <%v>, want: <%v>", it.Err(), context.Canceled)
+	}
+
+	if n != 10 {
+		t.Errorf("got: <%v> Games, want: <%v>", n, 10)
+	}
+}
+
+func TestGameIterator_Error(t *testing.T) {
+	ts, c := testServerString(http.StatusBadRequest, "")
+	defer ts.Close()
+
+	it := c.Games.Iterate(context.Background())
+	if it.Next() {
+		t.Fatal("got: <true>, want: <false>")
+	}
+
+	if errors.Cause(it.Err()) != ErrBadRequest {
+		t.Errorf("got: <%v>, want: <%v>", errors.Cause(it.Err()), ErrBadRequest)
+	}
+}
+
+func TestGameIterator_Count(t *testing.T) {
+	var pages int32
+	ts, c := startPagingServer(42, &pages)
+	defer ts.Close()
+
+	ct, err := c.Games.Iterate(context.Background(), SetLimit(10)).Count()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ct != 42 {
+		t.Errorf("got: <%v>, want: <%v>", ct, 42)
+	}
+
+	ct, err = c.Games.IterateSearch(context.Background(), "mario").Count()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ct != 42 {
+		t.Errorf("got: <%v>, want: <%v>", ct, 42)
+	}
+}
+
+func TestGameIterator_Search(t *testing.T) {
+	var pages int32
+	ts, c := startPagingServer(15, &pages)
+	defer ts.Close()
+
+	it := c.Games.IterateSearch(context.Background(), "mario", SetLimit(10))
+
+	n := 0
+	for it.Next() {
+		n++
+	}
+
+	if it.Err() != nil {
+		t.Fatal(it.Err())
+	}
+
+	if n != 15 {
+		t.Errorf("got: <%v> Games, want: <%v>", n, 15)
+	}
+}
+
+func TestGameIterator_Scan(t *testing.T) {
+	tests := []struct {
+		name       string
+		total      int
+		cursor     int
+		opts       []Option
+		wantFirst  int
+		wantN      int
+		wantCursor int
+		wantPages  int32
+		wantErr    error
+	}{
+		{"Several pages", 25, 0, []Option{SetLimit(10)}, 1, 25, 25, 3, nil},
+		{"Exact pages", 20, 0, []Option{SetLimit(10)}, 1, 20, 20, 3, nil},
+		{"Past maximum offset", 6000, 0, nil, 1, 6000, 6000, 13, nil},
+		{"Resumed from cursor", 25, 12, []Option{SetLimit(10)}, 13, 13, 25, 2, nil},
+		{"Cursor past results", 25, 30, []Option{SetLimit(10)}, 0, 0, 30, 1, nil},
+		{"Negative cursor", 25, -1, nil, 0, 0, -1, 0, ErrNegativeID},
+		{"Offset not allowed", 25, 0, []Option{SetOffset(10)}, 0, 0, 0, 0, ErrScanOffset},
+		{"Order not allowed", 25, 0, []Option{SetOrder("name", OrderAscending)}, 0, 0, 0, 0, ErrScanOrder},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var pages int32
+			ts, c := startPagingServer(test.total, &pages)
+			defer ts.Close()
+
+			it := c.Games.Scan(context.Background(), test.cursor, test.opts...)
+
+			n, first := 0, 0
+			for it.Next() {
+				n++
+				if first == 0 {
+					first = it.Value().ID
+				}
+				if it.Cursor() != it.Value().ID {
+					t.Fatalf("got: <%v> cursor, want: <%v>", it.Cursor(), it.Value().ID)
+				}
+			}
+
+			if errors.Cause(it.Err()) != test.wantErr {
+				t.Fatalf("got: <%v>, want: <%v>", errors.Cause(it.Err()), test.wantErr)
+			}
+
+			if n != test.wantN {
+				t.Errorf("got: <%v> Games, want: <%v>", n, test.wantN)
+			}
+
+			if first != test.wantFirst {
+				t.Errorf("got: <%v> first ID, want: <%v>", first, test.wantFirst)
+			}
+
+			if it.Cursor() != test.wantCursor {
+				t.Errorf("got: <%v> cursor, want: <%v>", it.Cursor(), test.wantCursor)
+			}
+
+			if pages != test.wantPages {
+				t.Errorf("got: <%v> pages, want: <%v>", pages, test.wantPages)
+			}
+		})
+	}
+}
+
+func TestGameIterator_ScanQuery(t *testing.T) {
+	var qrys []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		qrys = append(qrys, string(b))
+		if len(qrys) > 1 {
+			fmt.Fprint(w, "[]")
+			return
+		}
+		fmt.Fprint(w, `[{"id": 7}, {"id": 9}]`)
+	}))
+	defer ts.Close()
+
+	c := NewClient(testKey, ts.Client(), WithBaseURL(ts.URL))
+
+	it := c.Games.Scan(context.Background(), 3, SetFields("name"), SetFilter("category", OpEquals, "0"), SetLimit(2))
+	for it.Next() {
+	}
+
+	if it.Err() != nil {
+		t.Fatal(it.Err())
+	}
+
+	want := []string{
+		"fields name; where id > 3 & category = 0; sort id asc; limit 2; ",
+		"fields name; where id > 9 & category = 0; sort id asc; limit 2; ",
+	}
+	if !reflect.DeepEqual(qrys, want) {
+		t.Errorf("got: <%v>, want: <%v>", qrys, want)
+	}
+}
+
+func TestGameIterator_ScanCount(t *testing.T) {
+	var qry string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		qry = string(b)
+		fmt.Fprint(w, `{"count": 5}`)
+	}))
+	defer ts.Close()
+
+	c := NewClient(testKey, ts.Client(), WithBaseURL(ts.URL))
+
+	ct, err := c.Games.Scan(context.Background(), 100, SetFilter("category", OpEquals, "0")).Count()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ct != 5 {
+		t.Errorf("got: <%v>, want: <%v>", ct, 5)
+	}
+
+	if want := "where id > 100 & category = 0; "; qry != want {
+		t.Errorf("got: <%v>, want: <%v>", qry, want)
+	}
+}
+
+func TestGameIterator_ScanEmpty(t *testing.T) {
+	ts, c := testServerString(http.StatusOK, "[ ]\n")
+	defer ts.Close()
+
+	it := c.Games.Scan(context.Background(), 0)
+	if it.Next() {
+		t.Fatal("got: <true>, want: <false>")
+	}
+
+	if it.Err() != nil {
+		t.Errorf("got: <%v>, want: <nil>", it.Err())
+	}
+
+	if it.Cursor() != 0 {
+		t.Errorf("got: <%v> cursor, want: <%v>", it.Cursor(), 0)
+	}
+}
+
+func TestTestDummyIterator_ScanStalled(t *testing.T) {
+	var pages int32
+	ts, c := startPagingServer(25, &pages)
+	defer ts.Close()
+
+	it := c.TestDummies.Scan(context.Background(), 0, SetLimit(10))
+	for it.Next() {
+	}
+
+	if errors.Cause(it.Err()) != ErrScanStalled {
+		t.Errorf("got: <%v>, want: <%v>", errors.Cause(it.Err()), ErrScanStalled)
+	}
+
+	if pages != 1 {
+		t.Errorf("got: <%v> pages, want: <%v>", pages, 1)
+	}
+}
@@ -37,7 +37,7 @@ func TestPlatformService_Get(t *testing.T) {
 	}{
 		{"Valid response", testPlatformGet, 8, []Option{SetFields("name")}, init[0], nil},
 		{"Invalid ID", testFileEmpty, -1, nil, nil, ErrNegativeID},
-		{"Empty response", testFileEmpty, 8, nil, nil, errInvalidJSON},
+		{"Empty response", testFileEmpty, 8, nil, nil, ErrInvalidJSON},
 		{"Invalid option", testFileEmpty, 8, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
 		{"No results", testFileEmptyArray, 0, nil, nil, ErrNoResults},
 	}
@@ -84,7 +84,7 @@ func TestPlatformService_List(t *testing.T) {
 		{"Valid response", testPlatformList, []int{96, 74, 133, 44, 19}, []Option{SetLimit(5)}, init, nil},
 		{"Zero IDs", testFileEmpty, nil, nil, nil, ErrEmptyIDs},
 		{"Invalid ID", testFileEmpty, []int{-500}, nil, nil, ErrNegativeID},
-		{"Empty response", testFileEmpty, []int{96, 74, 133, 44, 19}, nil, nil, errInvalidJSON},
+		{"Empty response", testFileEmpty, []int{96, 74, 133, 44, 19}, nil, nil, ErrInvalidJSON},
 		{"Invalid option", testFileEmpty, []int{96, 74, 133, 44, 19}, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
 		{"No results", testFileEmptyArray, []int{0, 9999999}, nil, nil, ErrNoResults},
 	}
@@ -128,7 +128,7 @@ func TestPlatformService_Index(t *testing.T) {
 		wantErr       error
 	}{
 		{"Valid response", testPlatformList, []Option{SetLimit(5)}, init, nil},
-		{"Empty response", testFileEmpty, nil, nil, errInvalidJSON},
+		{"Empty response", testFileEmpty, nil, nil, ErrInvalidJSON},
 		{"Invalid option", testFileEmpty, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
 		{"No results", testFileEmptyArray, nil, nil, ErrNoResults},
 	}
@@ -174,7 +174,7 @@ func TestPlatformService_Search(t *testing.T) {
 	}{
 		{"Valid response", testPlatformSearch, "nintendo", []Option{SetFields("*")}, init, nil},
 		{"Empty query", testFileEmpty, "", []Option{SetLimit(50)}, nil, ErrEmptyQry},
-		{"Empty response", testFileEmpty, "nintendo", nil, nil, errInvalidJSON},
+		{"Empty response", testFileEmpty, "nintendo", nil, nil, ErrInvalidJSON},
 		{"Invalid option", testFileEmpty, "nintendo", []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
 		{"No results", testFileEmptyArray, "non-existent entry", nil, nil, ErrNoResults},
 	}
@@ -207,7 +207,7 @@ func TestPlatformService_Count(t *testing.T) {
 		wantErr   error
 	}{
 		{"Happy path", `{"count": 100}`, []Option{SetFilter("popularity", OpGreaterThan, "75")}, 100, nil},
-		{"Empty response", "", nil, 0, errInvalidJSON},
+		{"Empty response", "", nil, 0, ErrInvalidJSON},
 		{"Invalid option", "", []Option{SetLimit(-99999)}, 0, ErrOutOfRange},
 		{"No results", "[]", nil, 0, ErrNoResults},
 	}
@@ -239,7 +239,7 @@ func TestPlatformService_Fields(t *testing.T) {
 	}{
 		{"Happy path", `["name", "slug", "url"]`, []string{"url", "slug", "name"}, nil},
 		{"Asterisk", `["*"]`, []string{"*"}, nil},
-		{"Empty response", "", nil, errInvalidJSON},
+		{"Empty response", "", nil, ErrInvalidJSON},
 		{"No results", "[]", nil, nil},
 	}
 
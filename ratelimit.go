@@ -0,0 +1,222 @@
+package igdb
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RateLimit configures the client-side rate limiter used by a Client. Requests
+// are throttled by a token bucket that refills at Rate tokens per second and
+// holds at most Burst tokens. MaxConcurrent caps the number of requests that
+// may be open at the same time.
+//
+// For more information visit: https://api-docs.igdb.com/#rate-limits
+type RateLimit struct {
+	// Rate is the number of requests allowed per second.
+	Rate float64
+	// Burst is the number of requests that may be sent at once.
+	// If less than 1, Burst defaults to 1.
+	Burst int
+	// MaxConcurrent is the number of requests that may be open at the same
+	// time. If less than 1, the number of open requests is not capped.
+	MaxConcurrent int
+	// Adaptive pauses every request when the IGDB responds with a Too Many
+	// Requests status. The pause lasts until the time given by the response's
+	// Retry-After header or for one second if the header is missing.
+	Adaptive bool
+}
+
+// DefaultRateLimit matches the rate limits enforced by the IGDB: 4 requests per
+// second with up to 8 open requests at a time.
+var DefaultRateLimit = RateLimit{
+	Rate:          4,
+	Burst:         4,
+	MaxConcurrent: 8,
+	Adaptive:      true,
+}
+
+// defaultThrottlePause is how long an adaptive limiter pauses after a Too Many
+// Requests response without a Retry-After header.
+const defaultThrottlePause = time.Second
+
+// WithRateLimit is a client option used to throttle every request sent to the
+// IGDB using the provided RateLimit. A RateLimit with a non-positive Rate only
+// caps the number of open requests.
+func WithRateLimit(rl RateLimit) ClientOption {
+	return func(c *Client) {
+		c.limiter = newLimiter(rl)
+	}
+}
+
+// limiter is a token bucket combined with a semaphore that caps
+// the number of concurrent requests.
+type limiter struct {
+	mu          sync.Mutex
+	rate        float64
+	burst       float64
+	tokens      float64
+	last        time.Time
+	pausedUntil time.Time
+	adaptive    bool
+	sem         chan struct{}
+	now         func() time.Time
+}
+
+// newLimiter returns a limiter configured with the provided RateLimit.
+// The token bucket starts out full.
+func newLimiter(rl RateLimit) *limiter {
+	if rl.Burst < 1 {
+		rl.Burst = 1
+	}
+
+	l := &limiter{
+		rate:     rl.Rate,
+		burst:    float64(rl.Burst),
+		tokens:   float64(rl.Burst),
+		adaptive: rl.Adaptive,
+		now:      time.Now,
+	}
+
+	if rl.MaxConcurrent > 0 {
+		l.sem = make(chan struct{}, rl.MaxConcurrent)
+	}
+
+	l.last = l.now()
+	return l
+}
+
+// enter blocks until fewer than the maximum number of concurrent requests are
+// open or the provided context is done. The returned release function must be
+// called once the request is finished.
+func (l *limiter) enter(ctx context.Context) (func(), error) {
+	if l.sem == nil {
+		return func() {}, nil
+	}
+
+	select {
+	case l.sem <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	return func() { <-l.sem }, nil
+}
+
+// wait reserves a token from the bucket and blocks until the token is
+// available or the provided context is done. A canceled reservation
+// returns its token to the bucket.
+func (l *limiter) wait(ctx context.Context) error {
+	d := l.reserve()
+	if d <= 0 {
+		return nil
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		l.cancel()
+		return ctx.Err()
+	}
+}
+
+// reserve takes a token from the bucket and returns how long
+// the caller must wait before the token can be used.
+func (l *limiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+
+	var pause time.Duration
+	if now.Before(l.pausedUntil) {
+		pause = l.pausedUntil.Sub(now)
+	}
+
+	if l.rate <= 0 {
+		return pause
+	}
+
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+	l.tokens--
+
+	var d time.Duration
+	if l.tokens < 0 {
+		d = time.Duration(-l.tokens / l.rate * float64(time.Second))
+	}
+
+	if pause > d {
+		return pause
+	}
+	return d
+}
+
+// cancel returns a reserved token to the bucket.
+func (l *limiter) cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.rate > 0 {
+		l.tokens++
+	}
+}
+
+// observe inspects the provided response and, if the limiter is adaptive and
+// the IGDB responded with a Too Many Requests status, pauses every request
+// until the time given by the response's Retry-After header.
+func (l *limiter) observe(resp *http.Response) {
+	if !l.adaptive || resp.StatusCode != http.StatusTooManyRequests {
+		return
+	}
+
+	d, ok := retryAfter(resp, l.now())
+	if !ok {
+		d = defaultThrottlePause
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if until := l.now().Add(d); until.After(l.pausedUntil) {
+		l.pausedUntil = until
+	}
+}
+
+// retryAfter parses the Retry-After header of the provided response relative
+// to the provided time. The header may hold either a number of seconds or an
+// HTTP date. If the header is missing or invalid, false is returned.
+func retryAfter(resp *http.Response, now time.Time) (time.Duration, bool) {
+	h := resp.Header.Get("Retry-After")
+	if h == "" {
+		return 0, false
+	}
+
+	if sec, err := strconv.Atoi(h); err == nil {
+		if sec < 0 {
+			return 0, false
+		}
+		return time.Duration(sec) * time.Second, true
+	}
+
+	t, err := http.ParseTime(h)
+	if err != nil {
+		return 0, false
+	}
+
+	d := t.Sub(now)
+	if d < 0 {
+		d = 0
+	}
+
+	return d, true
+}
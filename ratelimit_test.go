@@ -0,0 +1,150 @@
+package igdb
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestLimiter_Reserve(t *testing.T) {
+	now := time.Now()
+	l := newLimiter(RateLimit{Rate: 4, Burst: 2})
+	l.now = func() time.Time { return now }
+	l.last = now
+
+	tests := []struct {
+		name     string
+		elapsed  time.Duration
+		wantWait time.Duration
+	}{
+		{"First burst token", 0, 0},
+		{"Second burst token", 0, 0},
+		{"Empty bucket", 0, 250 * time.Millisecond},
+		{"Bucket in debt", 0, 500 * time.Millisecond},
+		{"Partially refilled", time.Second, 0},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			now = now.Add(test.elapsed)
+
+			got := l.reserve()
+			if got != test.wantWait {
+				t.Errorf("got: <%v>, want: <%v>", got, test.wantWait)
+			}
+		})
+	}
+}
+
+func TestLimiter_Wait(t *testing.T) {
+	l := newLimiter(RateLimit{Rate: 50, Burst: 1})
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		if err := l.wait(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if elapsed := time.Since(start); elapsed < 75*time.Millisecond {
+		t.Errorf("got: <%v> for 5 requests, want at least: <%v>", elapsed, 75*time.Millisecond)
+	}
+}
+
+func TestLimiter_WaitContext(t *testing.T) {
+	l := newLimiter(RateLimit{Rate: 0.1, Burst: 1})
+
+	if err := l.wait(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := l.wait(ctx); err != context.DeadlineExceeded {
+		t.Errorf("got: <%v>, want: <%v>", err, context.DeadlineExceeded)
+	}
+
+	if l.tokens < -0.5 {
+		t.Errorf("got: <%v> tokens, want canceled reservation returned", l.tokens)
+	}
+}
+
+func TestLimiter_Observe(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name      string
+		adaptive  bool
+		status    int
+		header    string
+		wantPause time.Duration
+	}{
+		{"Not adaptive", false, http.StatusTooManyRequests, "5", 0},
+		{"OK status", true, http.StatusOK, "5", 0},
+		{"Retry-After seconds", true, http.StatusTooManyRequests, "5", 5 * time.Second},
+		{"Retry-After date", true, http.StatusTooManyRequests, now.Add(3 * time.Second).UTC().Format(http.TimeFormat), 3 * time.Second},
+		{"Missing Retry-After", true, http.StatusTooManyRequests, "", defaultThrottlePause},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			l := newLimiter(RateLimit{Rate: 4, Burst: 4, Adaptive: test.adaptive})
+			l.now = func() time.Time { return now }
+
+			resp := &http.Response{StatusCode: test.status, Header: http.Header{}}
+			if test.header != "" {
+				resp.Header.Set("Retry-After", test.header)
+			}
+
+			l.observe(resp)
+
+			got := l.reserve()
+			if got < test.wantPause-time.Second || got > test.wantPause {
+				t.Errorf("got: <%v>, want: <%v>", got, test.wantPause)
+			}
+		})
+	}
+}
+
+func TestClient_RateLimitConcurrency(t *testing.T) {
+	var open, maxOpen int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&open, 1)
+		defer atomic.AddInt32(&open, -1)
+
+		for {
+			m := atomic.LoadInt32(&maxOpen)
+			if n <= m || atomic.CompareAndSwapInt32(&maxOpen, m, n) {
+				break
+			}
+		}
+
+		time.Sleep(10 * time.Millisecond)
+		fmt.Fprint(w, testResult)
+	}))
+	defer ts.Close()
+
+	c := NewClient(testKey, ts.Client(), WithBaseURL(ts.URL), WithRateLimit(RateLimit{MaxConcurrent: 2}))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			res := testResultPlaceholder{}
+			if err := c.get(context.Background(), testEndpoint, &res); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if maxOpen > 2 {
+		t.Errorf("got: <%v> open requests, want at most: <%v>", maxOpen, 2)
+	}
+}
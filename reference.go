@@ -0,0 +1,139 @@
+package igdb
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// ErrNotExpanded occurs when decoding a Reference that
+// was returned as a bare ID instead of an expanded object.
+var ErrNotExpanded = errors.New("reference is not expanded")
+
+// Reference is a reference from one IGDB object to another. Depending on the
+// requested fields, the IGDB returns a reference either as the bare ID of the
+// referenced object (e.g. "cover") or as the referenced object itself when the
+// field is expanded (e.g. "cover.image_id" or "cover.*"). Reference decodes
+// both forms. The ID is always available while the expanded object can be
+// decoded into its own type with Decode.
+//
+// For more information visit: https://api-docs.igdb.com/#expander
+type Reference struct {
+	ID  int
+	raw json.RawMessage
+}
+
+// Expanded returns true if the Reference was returned as an expanded object.
+func (r Reference) Expanded() bool {
+	return len(r.raw) > 0
+}
+
+// Decode decodes the expanded object of the Reference into the value pointed to
+// by v (e.g. a *Cover). If the Reference is not expanded, ErrNotExpanded is returned.
+func (r Reference) Decode(v interface{}) error {
+	if !r.Expanded() {
+		return ErrNotExpanded
+	}
+
+	if err := json.Unmarshal(r.raw, v); err != nil {
+		return errors.Wrap(ErrInvalidJSON, err.Error())
+	}
+
+	return nil
+}
+
+// UnmarshalJSON decodes either a bare ID or an expanded object into the Reference.
+func (r *Reference) UnmarshalJSON(b []byte) error {
+	b = bytes.TrimSpace(b)
+	if bytes.Equal(b, []byte("null")) {
+		*r = Reference{}
+		return nil
+	}
+
+	if len(b) > 0 && b[0] == '{' {
+		var obj struct {
+			ID int `json:"id"`
+		}
+		if err := json.Unmarshal(b, &obj); err != nil {
+			return err
+		}
+
+		r.ID = obj.ID
+		r.raw = append(json.RawMessage(nil), b...)
+		return nil
+	}
+
+	id, err := strconv.Atoi(string(b))
+	if err != nil {
+		return errors.Errorf("cannot decode reference from %s", b)
+	}
+
+	*r = Reference{ID: id}
+	return nil
+}
+
+// MarshalJSON encodes the Reference the same way it was received: as the
+// expanded object if it was expanded, otherwise as the bare ID.
+func (r Reference) MarshalJSON() ([]byte, error) {
+	if r.Expanded() {
+		return r.raw, nil
+	}
+
+	return []byte(strconv.Itoa(r.ID)), nil
+}
+
+// String returns the ID of the Reference as a string.
+func (r Reference) String() string {
+	return strconv.Itoa(r.ID)
+}
+
+// References is a list of references from one IGDB object to others.
+type References []Reference
+
+// IDs returns the IDs of the References.
+func (rs References) IDs() []int {
+	ids := make([]int, len(rs))
+	for i, r := range rs {
+		ids[i] = r.ID
+	}
+
+	return ids
+}
+
+// Expanded returns true if every Reference was returned as an expanded object.
+func (rs References) Expanded() bool {
+	for _, r := range rs {
+		if !r.Expanded() {
+			return false
+		}
+	}
+
+	return len(rs) > 0
+}
+
+// Decode decodes the expanded objects of the References into the slice pointed
+// to by v (e.g. a *[]*Genre). If any Reference is not expanded, ErrNotExpanded
+// is returned.
+func (rs References) Decode(v interface{}) error {
+	b := bytes.Buffer{}
+	b.WriteByte('[')
+	for i, r := range rs {
+		if !r.Expanded() {
+			return ErrNotExpanded
+		}
+
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.Write(r.raw)
+	}
+	b.WriteByte(']')
+
+	if err := json.Unmarshal(b.Bytes(), v); err != nil {
+		return errors.Wrap(ErrInvalidJSON, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,176 @@
+package igdb
+
+import (
+	"encoding/json"
+	"github.com/pkg/errors"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+const testGameGetExpanded string = "test_data/game_get_expanded.json"
+
+func TestReference_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name         string
+		data         string
+		wantID       int
+		wantExpanded bool
+		wantErr      bool
+	}{
+		{"Bare ID", `1942`, 1942, false, false},
+		{"Zero ID", `0`, 0, false, false},
+		{"Null", `null`, 0, false, false},
+		{"Expanded object", `{"id": 89386, "image_id": "co1wyy"}`, 89386, true, false},
+		{"Expanded object without ID", `{"name": "Adventure"}`, 0, true, false},
+		{"String", `"1942"`, 0, false, true},
+		{"Array", `[1942]`, 0, false, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var r Reference
+
+			err := json.Unmarshal([]byte(test.data), &r)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("got: <%v>, want error: <%v>", err, test.wantErr)
+			}
+
+			if r.ID != test.wantID {
+				t.Errorf("got: <%v>, want: <%v>", r.ID, test.wantID)
+			}
+
+			if r.Expanded() != test.wantExpanded {
+				t.Errorf("got: <%v>, want: <%v>", r.Expanded(), test.wantExpanded)
+			}
+		})
+	}
+}
+
+func TestReference_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"Bare ID", `1942`},
+		{"Expanded object", `{"id":89386,"image_id":"co1wyy"}`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var r Reference
+			if err := json.Unmarshal([]byte(test.data), &r); err != nil {
+				t.Fatal(err)
+			}
+
+			b, err := json.Marshal(r)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if string(b) != test.data {
+				t.Errorf("got: <%v>, want: <%v>", string(b), test.data)
+			}
+		})
+	}
+}
+
+func TestReference_Decode(t *testing.T) {
+	var expanded, bare Reference
+	if err := json.Unmarshal([]byte(`{"id": 89386, "image_id": "co1wyy"}`), &expanded); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal([]byte(`89386`), &bare); err != nil {
+		t.Fatal(err)
+	}
+
+	var cov Cover
+	if err := expanded.Decode(&cov); err != nil {
+		t.Fatal(err)
+	}
+
+	want := Cover{ID: 89386, Image: Image{ImageID: "co1wyy"}}
+	if !reflect.DeepEqual(cov, want) {
+		t.Errorf("got: <%v>, want: <%v>", cov, want)
+	}
+
+	if err := bare.Decode(&cov); err != ErrNotExpanded {
+		t.Errorf("got: <%v>, want: <%v>", err, ErrNotExpanded)
+	}
+}
+
+func TestReferences_Decode(t *testing.T) {
+	tests := []struct {
+		name       string
+		data       string
+		wantIDs    []int
+		wantGenres []*Genre
+		wantErr    error
+	}{
+		{
+			"Expanded objects",
+			`[{"id": 12, "name": "Role-playing (RPG)"}, {"id": 31, "name": "Adventure"}]`,
+			[]int{12, 31},
+			[]*Genre{{ID: 12, Name: "Role-playing (RPG)"}, {ID: 31, Name: "Adventure"}},
+			nil,
+		},
+		{"Bare IDs", `[12, 31]`, []int{12, 31}, nil, ErrNotExpanded},
+		{"Empty", `[]`, []int{}, []*Genre{}, nil},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var rs References
+			if err := json.Unmarshal([]byte(test.data), &rs); err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(rs.IDs(), test.wantIDs) {
+				t.Errorf("got: <%v>, want: <%v>", rs.IDs(), test.wantIDs)
+			}
+
+			var genres []*Genre
+
+			err := rs.Decode(&genres)
+			if errors.Cause(err) != test.wantErr {
+				t.Fatalf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(genres, test.wantGenres) {
+				t.Errorf("got: <%v>, want: <%v>", genres, test.wantGenres)
+			}
+		})
+	}
+}
+
+func TestGameService_GetExpanded(t *testing.T) {
+	ts, c, err := testServerFile(http.StatusOK, testGameGetExpanded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ts.Close()
+
+	g, err := c.Games.Get(1942, SetFields("name", "cover.image_id", "genres.name", "platforms"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var cov Cover
+	if err := g.Cover.Decode(&cov); err != nil {
+		t.Fatal(err)
+	}
+
+	if cov.ImageID != "co1wyy" || g.Cover.ID != 89386 {
+		t.Errorf("got: <%v>, want cover 89386 with image ID co1wyy", cov)
+	}
+
+	var genres []*Genre
+	if err := g.Genres.Decode(&genres); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(genres) != 2 || genres[1].Name != "Adventure" {
+		t.Errorf("got: <%v>, want 2 genres", genres)
+	}
+
+	if !reflect.DeepEqual(g.Platforms.IDs(), []int{6, 48, 49}) || g.Platforms.Expanded() {
+		t.Errorf("got: <%v>, want unexpanded platforms 6, 48, 49", g.Platforms)
+	}
+}
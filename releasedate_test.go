@@ -33,7 +33,7 @@ func TestReleaseDateService_Get(t *testing.T) {
 	}{
 		{"Valid response", testReleaseDateGet, 26259, []Option{SetFields("name")}, init[0], nil},
 		{"Invalid ID", testFileEmpty, -1, nil, nil, ErrNegativeID},
-		{"Empty response", testFileEmpty, 26259, nil, nil, errInvalidJSON},
+		{"Empty response", testFileEmpty, 26259, nil, nil, ErrInvalidJSON},
 		{"Invalid option", testFileEmpty, 26259, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
 		{"No results", testFileEmptyArray, 0, nil, nil, ErrNoResults},
 	}
@@ -77,7 +77,7 @@ func TestReleaseDateService_List(t *testing.T) {
 		{"Valid response", testReleaseDateList, []int{16309, 52698, 16321, 106291, 16905}, []Option{SetLimit(5)}, init, nil},
 		{"Zero IDs", testFileEmpty, nil, nil, nil, ErrEmptyIDs},
 		{"Invalid ID", testFileEmpty, []int{-500}, nil, nil, ErrNegativeID},
-		{"Empty response", testFileEmpty, []int{16309, 52698, 16321, 106291, 16905}, nil, nil, errInvalidJSON},
+		{"Empty response", testFileEmpty, []int{16309, 52698, 16321, 106291, 16905}, nil, nil, ErrInvalidJSON},
 		{"Invalid option", testFileEmpty, []int{16309, 52698, 16321, 106291, 16905}, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
 		{"No results", testFileEmptyArray, []int{0, 9999999}, nil, nil, ErrNoResults},
 	}
@@ -118,7 +118,7 @@ func TestReleaseDateService_Index(t *testing.T) {
 		wantErr          error
 	}{
 		{"Valid response", testReleaseDateList, []Option{SetLimit(5)}, init, nil},
-		{"Empty response", testFileEmpty, nil, nil, errInvalidJSON},
+		{"Empty response", testFileEmpty, nil, nil, ErrInvalidJSON},
 		{"Invalid option", testFileEmpty, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
 		{"No results", testFileEmptyArray, nil, nil, ErrNoResults},
 	}
@@ -151,7 +151,7 @@ func TestReleaseDateService_Count(t *testing.T) {
 		wantErr   error
 	}{
 		{"Happy path", `{"count": 100}`, []Option{SetFilter("popularity", OpGreaterThan, "75")}, 100, nil},
-		{"Empty response", "", nil, 0, errInvalidJSON},
+		{"Empty response", "", nil, 0, ErrInvalidJSON},
 		{"Invalid option", "", []Option{SetLimit(-99999)}, 0, ErrOutOfRange},
 		{"No results", "[]", nil, 0, ErrNoResults},
 	}
@@ -183,7 +183,7 @@ func TestReleaseDateService_Fields(t *testing.T) {
 	}{
 		{"Happy path", `["name", "slug", "url"]`, []string{"url", "slug", "name"}, nil},
 		{"Asterisk", `["*"]`, []string{"*"}, nil},
-		{"Empty response", "", nil, errInvalidJSON},
+		{"Empty response", "", nil, ErrInvalidJSON},
 		{"No results", "[]", nil, nil},
 	}
 
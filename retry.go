@@ -0,0 +1,141 @@
+package igdb
+
+import (
+	"context"
+	"math/rand"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// RetryPolicy configures how a Client retries requests that fail with a
+// transient error. A failed request is retried with an exponential backoff
+// until it succeeds, until it fails with an error the policy does not consider
+// retryable, or until MaxAttempts is reached.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of times a request is sent,
+	// including the first attempt. A value less than 2 disables retries.
+	MaxAttempts int
+	// MinBackoff is the backoff before the first retry. The backoff doubles
+	// for each following retry.
+	MinBackoff time.Duration
+	// MaxBackoff caps the backoff between two attempts.
+	MaxBackoff time.Duration
+	// Retryable reports whether a request that ended with the provided
+	// response and error should be retried. The response is nil if the
+	// request could not be sent. If Retryable is nil, DefaultRetryable
+	// is used instead.
+	Retryable func(resp *http.Response, err error) bool
+}
+
+// DefaultRetryPolicy retries transient failures up to three times with a
+// backoff starting at 250 milliseconds.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 4,
+	MinBackoff:  250 * time.Millisecond,
+	MaxBackoff:  8 * time.Second,
+	Retryable:   DefaultRetryable,
+}
+
+// DefaultRetryable reports whether a request should be retried. Network errors
+// and responses with a Too Many Requests, Internal Server Error, Bad Gateway,
+// Service Unavailable, or Gateway Timeout status are considered retryable.
+// Requests that failed before reaching the network, such as requests missing
+// credentials, and requests that failed because their context is done are
+// never retried.
+func DefaultRetryable(resp *http.Response, err error) bool {
+	if resp == nil {
+		var nerr net.Error
+		return errors.As(err, &nerr) && !isContextErr(err)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	}
+
+	return false
+}
+
+// WithRetry is a client option used to retry requests that fail with a
+// transient error according to the provided RetryPolicy.
+func WithRetry(p RetryPolicy) ClientOption {
+	return func(c *Client) {
+		c.retry = &p
+	}
+}
+
+// shouldRetry reports whether a request on its given attempt that ended
+// with the provided response and error should be retried.
+func (p *RetryPolicy) shouldRetry(attempt int, resp *http.Response, err error) bool {
+	if p == nil || err == nil || attempt >= p.MaxAttempts {
+		return false
+	}
+
+	retryable := p.Retryable
+	if retryable == nil {
+		retryable = DefaultRetryable
+	}
+
+	return retryable(resp, err)
+}
+
+// backoff returns how long to wait before the retry following the provided
+// attempt. The backoff grows exponentially from MinBackoff up to MaxBackoff
+// and is jittered by up to half its length. If the provided response has a
+// Retry-After header, the time given by the header, capped at MaxBackoff,
+// is used instead.
+func (p *RetryPolicy) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if d, ok := retryAfter(resp, time.Now()); ok {
+			if p.MaxBackoff > 0 && d > p.MaxBackoff {
+				d = p.MaxBackoff
+			}
+			return d
+		}
+	}
+
+	d := p.MinBackoff
+	for i := 1; i < attempt && (p.MaxBackoff <= 0 || d < p.MaxBackoff); i++ {
+		d *= 2
+	}
+
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+
+	if half := int64(d / 2); half > 0 {
+		d = time.Duration(half + rand.Int63n(half+1))
+	}
+
+	return d
+}
+
+// sleep blocks for the provided duration or until the provided context is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// isContextErr reports whether the provided error was caused
+// by a canceled context or an exceeded deadline.
+func isContextErr(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
@@ -0,0 +1,199 @@
+package igdb
+
+import (
+	"context"
+	"fmt"
+	"github.com/pkg/errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// testRetryPolicy retries quickly so that tests stay fast.
+var testRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	MinBackoff:  time.Millisecond,
+	MaxBackoff:  5 * time.Millisecond,
+}
+
+// startFlakyServer initializes and returns a test server that responds with the
+// provided failure statuses in order before responding successfully. The provided
+// counter is incremented for each request received.
+func startFlakyServer(calls *int32, failures ...int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := int(atomic.AddInt32(calls, 1))
+		if n <= len(failures) {
+			w.WriteHeader(failures[n-1])
+			fmt.Fprint(w, "[]")
+			return
+		}
+
+		fmt.Fprint(w, testResult)
+	}))
+}
+
+func TestClient_Retry(t *testing.T) {
+	tests := []struct {
+		name      string
+		policy    RetryPolicy
+		failures  []int
+		wantCalls int32
+		wantErr   error
+	}{
+		{"No failures", testRetryPolicy, nil, 1, nil},
+		{"Too many requests", testRetryPolicy, []int{http.StatusTooManyRequests}, 2, nil},
+		{"Transient server errors", testRetryPolicy, []int{http.StatusBadGateway, http.StatusServiceUnavailable}, 3, nil},
+		{"Gateway timeout", testRetryPolicy, []int{http.StatusGatewayTimeout}, 2, nil},
+		{"Attempts exhausted", testRetryPolicy, []int{500, 500, 500, 500}, 3, ErrInternalError},
+		{"Bad request not retried", testRetryPolicy, []int{http.StatusBadRequest}, 1, ErrBadRequest},
+		{"Retries disabled", RetryPolicy{MaxAttempts: 1}, []int{http.StatusInternalServerError}, 1, ErrInternalError},
+		{
+			"Custom retryable",
+			RetryPolicy{
+				MaxAttempts: 3,
+				Retryable:   func(resp *http.Response, err error) bool { return resp != nil && resp.StatusCode == http.StatusBadRequest },
+			},
+			[]int{http.StatusBadRequest},
+			2,
+			nil,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var calls int32
+			ts := startFlakyServer(&calls, test.failures...)
+			defer ts.Close()
+
+			c := NewClient(testKey, ts.Client(), WithBaseURL(ts.URL), WithRetry(test.policy))
+
+			res := testResultPlaceholder{}
+
+			err := c.get(context.Background(), testEndpoint, &res, SetLimit(5))
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if calls != test.wantCalls {
+				t.Errorf("got: <%v> calls, want: <%v>", calls, test.wantCalls)
+			}
+		})
+	}
+}
+
+func TestClient_RetryNetworkError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	url := ts.URL
+	ts.Close()
+
+	var attempts int32
+	policy := testRetryPolicy
+	policy.Retryable = func(resp *http.Response, err error) bool {
+		atomic.AddInt32(&attempts, 1)
+		return DefaultRetryable(resp, err)
+	}
+
+	c := NewClient(testKey, nil, WithBaseURL(url), WithRetry(policy))
+
+	res := testResultPlaceholder{}
+
+	if err := c.get(context.Background(), testEndpoint, &res); err == nil {
+		t.Fatal("got: <nil>, want: network error")
+	}
+
+	if attempts != 2 {
+		t.Errorf("got: <%v> retry decisions, want: <%v>", attempts, 2)
+	}
+}
+
+func TestDefaultRetryable(t *testing.T) {
+	netErr := &url.Error{Op: "Post", URL: "https://example.com", Err: &net.OpError{Op: "dial", Err: errors.New("connection refused")}}
+
+	tests := []struct {
+		name   string
+		status int
+		err    error
+		want   bool
+	}{
+		{"Network error", 0, netErr, true},
+		{"Wrapped network error", 0, errors.Wrap(netErr, "cannot send request"), true},
+		{"Missing credentials", 0, ErrMissingCredentials, false},
+		{"Token request error", 0, errors.Wrap(ErrTokenRequest, "cannot retrieve token"), false},
+		{"Invalid JSON", 0, ErrInvalidJSON, false},
+		{"Canceled context", 0, &url.Error{Op: "Post", URL: "https://example.com", Err: context.Canceled}, false},
+		{"Service unavailable", http.StatusServiceUnavailable, ErrInternalError, true},
+		{"Bad request", http.StatusBadRequest, ErrBadRequest, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var resp *http.Response
+			if test.status != 0 {
+				resp = &http.Response{StatusCode: test.status}
+			}
+
+			if got := DefaultRetryable(resp, test.err); got != test.want {
+				t.Errorf("got: <%v>, want: <%v>", got, test.want)
+			}
+		})
+	}
+}
+
+func TestClient_RetryContext(t *testing.T) {
+	var calls int32
+	ts := startFlakyServer(&calls, http.StatusServiceUnavailable, http.StatusServiceUnavailable)
+	defer ts.Close()
+
+	policy := RetryPolicy{MaxAttempts: 3, MinBackoff: time.Hour}
+	c := NewClient(testKey, ts.Client(), WithBaseURL(ts.URL), WithRetry(policy))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	res := testResultPlaceholder{}
+
+	err := c.get(ctx, testEndpoint, &res)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got: <%v>, want: <%v>", err, context.DeadlineExceeded)
+	}
+
+	if calls != 1 {
+		t.Errorf("got: <%v> calls, want: <%v>", calls, 1)
+	}
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	p := RetryPolicy{MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+
+	tests := []struct {
+		name    string
+		attempt int
+		header  string
+		wantMin time.Duration
+		wantMax time.Duration
+	}{
+		{"First retry", 1, "", 50 * time.Millisecond, 100 * time.Millisecond},
+		{"Second retry", 2, "", 100 * time.Millisecond, 200 * time.Millisecond},
+		{"Third retry", 3, "", 200 * time.Millisecond, 400 * time.Millisecond},
+		{"Capped retry", 10, "", 500 * time.Millisecond, time.Second},
+		{"Retry-After header", 1, "1", time.Second, time.Second},
+		{"Capped Retry-After header", 1, "3", time.Second, time.Second},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp := &http.Response{Header: http.Header{}}
+			if test.header != "" {
+				resp.Header.Set("Retry-After", test.header)
+			}
+
+			for i := 0; i < 20; i++ {
+				got := p.backoff(test.attempt, resp)
+				if got < test.wantMin || got > test.wantMax {
+					t.Fatalf("got: <%v>, want between: <%v> and <%v>", got, test.wantMin, test.wantMax)
+				}
+			}
+		})
+	}
+}
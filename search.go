@@ -1,25 +1,29 @@
 package igdb
 
-import "github.com/pkg/errors"
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
 
 //go:generate gomodifytags -file $GOFILE -struct SearchResult -add-tags json -w
 
 // SearchResult represents a result from searching the IGDB.
 // It can contain: Characters, Collections Games, People, Platforms, and Themes.
 type SearchResult struct {
-	AlternativeName string  `json:"alternative_name"`
-	Character       int     `json:"character"`
-	Collection      int     `json:"collection"`
-	Company         int     `json:"company"`
-	Description     string  `json:"description"`
-	Game            int     `json:"game"`
-	Name            string  `json:"name"`
-	Person          int     `json:"person"`
-	Platform        int     `json:"platform"`
-	Popularity      float64 `json:"popularity"`
-	PublishedAt     int     `json:"published_at"`
-	TestDummy       int     `json:"test_dummy"`
-	Theme           int     `json:"theme"`
+	AlternativeName string    `json:"alternative_name"`
+	Character       Reference `json:"character"`
+	Collection      Reference `json:"collection"`
+	Company         Reference `json:"company"`
+	Description     string    `json:"description"`
+	Game            Reference `json:"game"`
+	Name            string    `json:"name"`
+	Person          Reference `json:"person"`
+	Platform        Reference `json:"platform"`
+	Popularity      float64   `json:"popularity"`
+	PublishedAt     Timestamp `json:"published_at"`
+	TestDummy       Reference `json:"test_dummy"`
+	Theme           Reference `json:"theme"`
 }
 
 // Search returns a list of SearchResults using the provided query. Provide functional
@@ -28,10 +32,16 @@ type SearchResult struct {
 // Search can only search through Characters, Collections, Games, People, Platforms,
 // and Themes.
 func (c *Client) Search(qry string, opts ...Option) ([]*SearchResult, error) {
+	return c.SearchContext(context.Background(), qry, opts...)
+}
+
+// SearchContext is like Search but uses the provided context to cancel the
+// request or set a deadline on it.
+func (c *Client) SearchContext(ctx context.Context, qry string, opts ...Option) ([]*SearchResult, error) {
 	var res []*SearchResult
 
 	opts = append(opts, setSearch(qry))
-	err := c.get(EndpointSearch, &res, opts...)
+	err := c.get(ctx, EndpointSearch, &res, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot perform search with query %s", qry)
 	}
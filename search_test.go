@@ -33,7 +33,7 @@ func TestClient_Search(t *testing.T) {
 	}{
 		{"Valid response", testSearch, "sonic", []Option{SetFields("*")}, init, nil},
 		{"Empty query", testFileEmpty, "", []Option{SetLimit(50)}, nil, ErrEmptyQry},
-		{"Empty response", testFileEmpty, "sonic", nil, nil, errInvalidJSON},
+		{"Empty response", testFileEmpty, "sonic", nil, nil, ErrInvalidJSON},
 		{"Invalid option", testFileEmpty, "sonic", []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
 		{"No results", testFileEmptyArray, "non-existent entry", nil, nil, ErrNoResults},
 	}
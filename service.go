@@ -0,0 +1,199 @@
+package igdb
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+
+	"github.com/Henry-Sarabia/sliceconv"
+	"github.com/pkg/errors"
+)
+
+// Service implements the API calls shared by every IGDB endpoint for
+// objects of type T, along with its iterators and loader. Every service of
+// the Client embeds a Service, or a SearchableService if its endpoint can be
+// searched.
+type Service[T any] struct {
+	client *Client
+	end    endpoint
+	// name and plural name the objects of type T in errors
+	// (e.g. "Person" and "People").
+	name   string
+	plural string
+	// id returns the ID of an object of type T.
+	id idFunc[T]
+}
+
+// newService returns a Service for the provided endpoint of the provided
+// Client. The provided plural is used to name objects of type T in errors.
+func newService[T any](c *Client, end endpoint, plural string) Service[T] {
+	return Service[T]{
+		client: c,
+		end:    end,
+		name:   reflect.TypeOf((*T)(nil)).Elem().Name(),
+		plural: plural,
+		id:     idOf[T](),
+	}
+}
+
+// idOf returns a function returning the ID field of an object of type T.
+// If T has no integer ID field, the returned function always returns 0.
+func idOf[T any]() idFunc[T] {
+	f, ok := reflect.TypeOf((*T)(nil)).Elem().FieldByName("ID")
+	if !ok || f.Type.Kind() != reflect.Int {
+		return func(*T) int { return 0 }
+	}
+
+	return func(v *T) int {
+		return int(reflect.ValueOf(v).Elem().FieldByIndex(f.Index).Int())
+	}
+}
+
+// Get returns a single object identified by the provided IGDB ID. Provide
+// the SetFields functional option if you need to specify which fields to
+// retrieve. If the ID does not match any objects, an error is returned.
+func (s *Service[T]) Get(id int, opts ...Option) (*T, error) {
+	return s.GetContext(context.Background(), id, opts...)
+}
+
+// GetContext is like Get but uses the provided context to cancel the
+// request or set a deadline on it.
+func (s *Service[T]) GetContext(ctx context.Context, id int, opts ...Option) (*T, error) {
+	if id < 0 {
+		return nil, ErrNegativeID
+	}
+
+	var res []*T
+
+	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := s.client.get(ctx, s.end, &res, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get %s with ID %v", s.name, id)
+	}
+
+	if len(res) == 0 {
+		return nil, errors.Wrapf(ErrNotFound, "cannot get %s with ID %v", s.name, id)
+	}
+
+	return res[0], nil
+}
+
+// List returns a list of objects identified by the provided list of IGDB IDs.
+// Provide functional options to sort, filter, and paginate the results.
+// Any ID that does not match an object is ignored. If none of the IDs
+// match an object, an error is returned.
+func (s *Service[T]) List(ids []int, opts ...Option) ([]*T, error) {
+	return s.ListContext(context.Background(), ids, opts...)
+}
+
+// ListContext is like List but uses the provided context to cancel the
+// request or set a deadline on it.
+func (s *Service[T]) ListContext(ctx context.Context, ids []int, opts ...Option) ([]*T, error) {
+	if len(ids) < 1 {
+		return nil, ErrEmptyIDs
+	}
+
+	for _, id := range ids {
+		if id < 0 {
+			return nil, ErrNegativeID
+		}
+	}
+
+	var res []*T
+
+	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
+	err := s.client.get(ctx, s.end, &res, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get %s with IDs %v", s.plural, ids)
+	}
+
+	return res, nil
+}
+
+// Index returns an index of objects based solely on the provided functional
+// options used to sort, filter, and paginate the results. If no objects can
+// be found using the provided options, an error is returned.
+func (s *Service[T]) Index(opts ...Option) ([]*T, error) {
+	return s.IndexContext(context.Background(), opts...)
+}
+
+// IndexContext is like Index but uses the provided context to cancel the
+// request or set a deadline on it.
+func (s *Service[T]) IndexContext(ctx context.Context, opts ...Option) ([]*T, error) {
+	var res []*T
+
+	err := s.client.get(ctx, s.end, &res, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get index of %s", s.plural)
+	}
+
+	return res, nil
+}
+
+// Count returns the number of objects available in the IGDB.
+// Provide the SetFilter functional option if you need to filter
+// which objects to count.
+func (s *Service[T]) Count(opts ...Option) (int, error) {
+	return s.CountContext(context.Background(), opts...)
+}
+
+// CountContext is like Count but uses the provided context to cancel the
+// request or set a deadline on it.
+func (s *Service[T]) CountContext(ctx context.Context, opts ...Option) (int, error) {
+	ct, err := s.client.getCount(ctx, s.end, opts...)
+	if err != nil {
+		return 0, errors.Wrapf(err, "cannot count %s", s.plural)
+	}
+
+	return ct, nil
+}
+
+// Fields returns the up-to-date list of fields in an
+// IGDB object of the endpoint.
+func (s *Service[T]) Fields() ([]string, error) {
+	return s.FieldsContext(context.Background())
+}
+
+// FieldsContext is like Fields but uses the provided context to cancel the
+// request or set a deadline on it.
+func (s *Service[T]) FieldsContext(ctx context.Context) ([]string, error) {
+	f, err := s.client.getFields(ctx, s.end)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get %s fields", s.name)
+	}
+
+	return f, nil
+}
+
+// SearchableService is a Service whose endpoint can also be searched.
+type SearchableService[T any] struct {
+	Service[T]
+}
+
+// newSearchableService returns a SearchableService for the provided endpoint
+// of the provided Client.
+func newSearchableService[T any](c *Client, end endpoint, plural string) SearchableService[T] {
+	return SearchableService[T]{newService[T](c, end, plural)}
+}
+
+// Search returns a list of objects found by searching the IGDB using the
+// provided query. Provide functional options to sort, filter, and paginate
+// the results. If no objects are found using the provided query, an error
+// is returned.
+func (s *SearchableService[T]) Search(qry string, opts ...Option) ([]*T, error) {
+	return s.SearchContext(context.Background(), qry, opts...)
+}
+
+// SearchContext is like Search but uses the provided context to cancel the
+// request or set a deadline on it.
+func (s *SearchableService[T]) SearchContext(ctx context.Context, qry string, opts ...Option) ([]*T, error) {
+	var res []*T
+
+	opts = append(opts, setSearch(qry))
+	err := s.client.get(ctx, s.end, &res, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get %s with query %s", s.name, qry)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,355 @@
+package igdb
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+// serviceItem is the object of the Services under test.
+type serviceItem struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// startServiceServer initializes and returns a test server that responds
+// to object, count, and meta requests alike. Object requests are answered
+// with the provided response. The path and body of every request are
+// recorded in the provided slice, separated by a space.
+func startServiceServer(resp string, reqs *[]string) (*httptest.Server, *Client) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		*reqs = append(*reqs, r.URL.Path+" "+string(b))
+
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/count"):
+			fmt.Fprint(w, `{"count": 3}`)
+		case strings.HasSuffix(r.URL.Path, "/meta"):
+			fmt.Fprint(w, `["id", "name"]`)
+		default:
+			fmt.Fprint(w, resp)
+		}
+	}))
+
+	return ts, NewClient(testKey, ts.Client(), WithBaseURL(ts.URL))
+}
+
+func TestService_Get(t *testing.T) {
+	var tests = []struct {
+		name     string
+		resp     string
+		id       int
+		wantItem *serviceItem
+		wantReq  string
+		wantErr  error
+	}{
+		{"Valid response", `[{"id": 5, "name": "five"}]`, 5, &serviceItem{ID: 5, Name: "five"}, "/test/ where id = 5; ", nil},
+		{"Negative ID", `[]`, -1, nil, "", ErrNegativeID},
+		{"Empty array", `[]`, 5, nil, "/test/ where id = 5; ", ErrNotFound},
+		{"Empty array with whitespace", "[ ]\n", 5, nil, "/test/ where id = 5; ", ErrNotFound},
+		{"Invalid JSON", `{`, 5, nil, "/test/ where id = 5; ", ErrInvalidJSON},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var reqs []string
+			ts, c := startServiceServer(test.resp, &reqs)
+			defer ts.Close()
+
+			s := newService[serviceItem](c, testEndpoint, "serviceItems")
+
+			item, err := s.Get(test.id)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(item, test.wantItem) {
+				t.Errorf("got: <%v>, want: <%v>", item, test.wantItem)
+			}
+
+			if got := strings.Join(reqs, "\n"); got != test.wantReq {
+				t.Errorf("got: <%v>, want: <%v>", got, test.wantReq)
+			}
+		})
+	}
+}
+
+func TestService_List(t *testing.T) {
+	var tests = []struct {
+		name      string
+		resp      string
+		ids       []int
+		wantItems []*serviceItem
+		wantReq   string
+		wantErr   error
+	}{
+		{"Valid response", `[{"id": 1}, {"id": 2}]`, []int{1, 2}, []*serviceItem{{ID: 1}, {ID: 2}}, "/test/ where id = (1,2); ", nil},
+		{"Nil IDs", `[]`, nil, nil, "", ErrEmptyIDs},
+		{"Empty IDs", `[]`, []int{}, nil, "", ErrEmptyIDs},
+		{"Negative ID", `[]`, []int{1, -2}, nil, "", ErrNegativeID},
+		{"No results", `[]`, []int{1, 2}, nil, "/test/ where id = (1,2); ", ErrNotFound},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var reqs []string
+			ts, c := startServiceServer(test.resp, &reqs)
+			defer ts.Close()
+
+			s := newService[serviceItem](c, testEndpoint, "serviceItems")
+
+			items, err := s.List(test.ids)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(items, test.wantItems) {
+				t.Errorf("got: <%v>, want: <%v>", items, test.wantItems)
+			}
+
+			if got := strings.Join(reqs, "\n"); got != test.wantReq {
+				t.Errorf("got: <%v>, want: <%v>", got, test.wantReq)
+			}
+		})
+	}
+}
+
+func TestService_Requests(t *testing.T) {
+	var reqs []string
+	ts, c := startServiceServer(`[{"id": 1}]`, &reqs)
+	defer ts.Close()
+
+	s := newSearchableService[serviceItem](c, testEndpoint, "serviceItems")
+
+	if _, err := s.Index(SetLimit(5)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Search("halo", SetFields("name")); err != nil {
+		t.Fatal(err)
+	}
+	if ct, err := s.Count(SetFilter("id", OpGreaterThan, "1")); err != nil || ct != 3 {
+		t.Errorf("got: <%v, %v>, want: <%v, %v>", ct, err, 3, nil)
+	}
+	if f, err := s.Fields(); err != nil || !equalSlice(f, []string{"id", "name"}) {
+		t.Errorf("got: <%v, %v>, want: <%v, %v>", f, err, []string{"id", "name"}, nil)
+	}
+
+	want := []string{
+		"/test/ limit 5; ",
+		`/test/ fields name; search "halo"; `,
+		"/test/count where id > 1; ",
+		"/test/meta ",
+	}
+	if !reflect.DeepEqual(reqs, want) {
+		t.Errorf("got: <%q>, want: <%q>", reqs, want)
+	}
+}
+
+func TestService_Errors(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer ts.Close()
+
+	c := NewClient(testKey, ts.Client(), WithBaseURL(ts.URL))
+	s := c.Persons
+
+	var tests = []struct {
+		name string
+		call func() error
+		want string
+	}{
+		{"Get", func() error { _, err := s.Get(1); return err }, "cannot get Person with ID 1"},
+		{"List", func() error { _, err := s.List([]int{1, 2}); return err }, "cannot get People with IDs [1 2]"},
+		{"Index", func() error { _, err := s.Index(); return err }, "cannot get index of People"},
+		{"Search", func() error { _, err := s.Search("kojima"); return err }, "cannot get Person with query kojima"},
+		{"Count", func() error { _, err := s.Count(); return err }, "cannot count People"},
+		{"Fields", func() error { _, err := s.Fields(); return err }, "cannot get Person fields"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.call()
+			if err == nil || !strings.HasPrefix(err.Error(), test.want+": ") {
+				t.Errorf("got: <%v>, want error starting with: <%v>", err, test.want)
+			}
+
+			if errors.Cause(err) != ErrBadRequest {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), ErrBadRequest)
+			}
+		})
+	}
+}
+
+func TestService_Iterate(t *testing.T) {
+	var reqs []string
+	ts, c := startServiceServer(`[{"id": 4, "name": "four"}, {"id": 7, "name": "seven"}]`, &reqs)
+	defer ts.Close()
+
+	s := newService[serviceItem](c, testEndpoint, "serviceItems")
+
+	it := s.Iterate(context.Background(), SetLimit(5))
+
+	var ids []int
+	for it.Next() {
+		ids = append(ids, it.Value().ID)
+		if it.Cursor() != it.Value().ID {
+			t.Errorf("got: <%v> cursor, want: <%v>", it.Cursor(), it.Value().ID)
+		}
+	}
+
+	if it.Err() != nil {
+		t.Fatal(it.Err())
+	}
+
+	if want := []int{4, 7}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("got: <%v>, want: <%v>", ids, want)
+	}
+
+	ct, err := it.Count()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ct != 3 {
+		t.Errorf("got: <%v>, want: <%v>", ct, 3)
+	}
+
+	want := []string{"/test/ limit 5; offset 0; ", "/test/count limit 5; "}
+	if !reflect.DeepEqual(reqs, want) {
+		t.Errorf("got: <%q>, want: <%q>", reqs, want)
+	}
+}
+
+func TestIDOf(t *testing.T) {
+	if got := idOf[serviceItem]()(&serviceItem{ID: 5}); got != 5 {
+		t.Errorf("got: <%v>, want: <%v>", got, 5)
+	}
+
+	type noID struct {
+		Name string
+	}
+
+	if got := idOf[noID]()(&noID{Name: "five"}); got != 0 {
+		t.Errorf("got: <%v>, want: <%v>", got, 0)
+	}
+}
+
+// TestClient_Services runs every operation of every service of the Client
+// against a test server, through reflection, to ensure every service is
+// wired to its own endpoint and behaves the same.
+func TestClient_Services(t *testing.T) {
+	ctx := reflect.ValueOf(context.Background())
+
+	var reqs []string
+	ts, c := startServiceServer(`[{"id": 1}]`, &reqs)
+	defer ts.Close()
+
+	empty, ce := startServiceServer("[ ]", &[]string{})
+	defer empty.Close()
+
+	v := reflect.ValueOf(c).Elem()
+	seen := make(map[string]string)
+	services := 0
+
+	for i := 0; i < v.NumField(); i++ {
+		svc := v.Field(i)
+		if svc.Kind() != reflect.Ptr || !strings.HasSuffix(svc.Type().Elem().Name(), "Service") {
+			continue
+		}
+		services++
+
+		name := v.Type().Field(i).Name
+		t.Run(name, func(t *testing.T) {
+			end := svc.Elem().FieldByName("end").String()
+			if end == "" || !strings.HasSuffix(end, "/") {
+				t.Fatalf("got: <%v>, want endpoint ending with a slash", end)
+			}
+			if other, ok := seen[end]; ok {
+				t.Errorf("got: <%v> shared with <%v>, want unique endpoint", end, other)
+			}
+			seen[end] = name
+
+			if svc.Elem().FieldByName("plural").String() == "" {
+				t.Error("got: empty plural name, want plural name")
+			}
+
+			ops := []struct {
+				method  string
+				args    []reflect.Value
+				wantReq string
+			}{
+				{"GetContext", []reflect.Value{ctx, reflect.ValueOf(1)}, "/" + end + " where id = 1; "},
+				{"ListContext", []reflect.Value{ctx, reflect.ValueOf([]int{1, 2})}, "/" + end + " where id = (1,2); "},
+				{"IndexContext", []reflect.Value{ctx, reflect.ValueOf(SetLimit(2))}, "/" + end + " limit 2; "},
+				{"SearchContext", []reflect.Value{ctx, reflect.ValueOf("halo")}, "/" + end + ` search "halo"; `},
+				{"CountContext", []reflect.Value{ctx}, "/" + end + "count "},
+				{"FieldsContext", []reflect.Value{ctx}, "/" + end + "meta "},
+			}
+
+			for _, op := range ops {
+				m := svc.MethodByName(op.method)
+				if !m.IsValid() {
+					if op.method != "SearchContext" {
+						t.Errorf("got: no method, want method <%v>", op.method)
+					}
+					continue
+				}
+
+				reqs = nil
+				out := m.Call(op.args)
+				if err := out[len(out)-1]; !err.IsNil() {
+					t.Errorf("%s got: <%v>, want: <%v>", op.method, err.Interface(), nil)
+				}
+				if out[0].Kind() != reflect.Int && out[0].IsNil() {
+					t.Errorf("%s got: <nil>, want result", op.method)
+				}
+				if len(reqs) != 1 || reqs[0] != op.wantReq {
+					t.Errorf("%s got: <%q>, want: <%q>", op.method, reqs, op.wantReq)
+				}
+			}
+
+			get := reflect.ValueOf(ce).Elem().Field(i).MethodByName("GetContext")
+			out := get.Call([]reflect.Value{ctx, reflect.ValueOf(1)})
+			if err, _ := out[1].Interface().(error); errors.Cause(err) != ErrNotFound {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), ErrNotFound)
+			}
+
+			// TestDummy decodes its ID from the mistagged "int" field,
+			// so its objects cannot be scanned or loaded by ID.
+			if name == "TestDummies" {
+				return
+			}
+
+			reqs = nil
+			it := svc.MethodByName("Scan").Call([]reflect.Value{ctx, reflect.ValueOf(0), reflect.ValueOf(SetLimit(2))})[0]
+			if !it.MethodByName("Next").Call(nil)[0].Bool() {
+				t.Errorf("Scan got: <%v>, want result", it.MethodByName("Err").Call(nil)[0].Interface())
+			}
+			if want := "/" + end + " where id > 0; sort id asc; limit 2; "; len(reqs) != 1 || reqs[0] != want {
+				t.Errorf("Scan got: <%q>, want: <%q>", reqs, want)
+			}
+
+			reqs = nil
+			l := svc.MethodByName("Loader").Call([]reflect.Value{ctx})[0]
+			out = l.MethodByName("Load").Call([]reflect.Value{reflect.ValueOf(1)})
+			if err := out[1]; !err.IsNil() {
+				t.Errorf("Load got: <%v>, want: <%v>", err.Interface(), nil)
+			}
+			if want := "/" + end + " where id = (1); limit 1; "; len(reqs) != 1 || reqs[0] != want {
+				t.Errorf("Load got: <%q>, want: <%q>", reqs, want)
+			}
+		})
+	}
+
+	if services == 0 || len(seen) != services {
+		t.Errorf("got: <%v> endpoints, want: <%v>", len(seen), services)
+	}
+}
@@ -1,6 +1,10 @@
 package igdb
 
-import "github.com/pkg/errors"
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
 
 //go:generate gomodifytags -file $GOFILE -struct Status -add-tags json -w
 
@@ -30,9 +34,15 @@ type UsageReport struct {
 // requests made in the current period and when that period ends.
 // For more information visit: https://api-docs.igdb.com/#api-status
 func (c *Client) Status() (*Status, error) {
+	return c.StatusContext(context.Background())
+}
+
+// StatusContext is like Status but uses the provided context to cancel the
+// request or set a deadline on it.
+func (c *Client) StatusContext(ctx context.Context) (*Status, error) {
 	var stat []*Status
 
-	err := c.get(EndpointStatus, &stat, []Option{}...)
+	err := c.get(ctx, EndpointStatus, &stat, []Option{}...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get API status")
 	}
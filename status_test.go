@@ -30,7 +30,7 @@ func TestClient_Status(t *testing.T) {
 		wantErr  error
 	}{
 		{"Valid response", testStatus, init[0], nil},
-		{"Empty response", testFileEmpty, nil, errInvalidJSON},
+		{"Empty response", testFileEmpty, nil, ErrInvalidJSON},
 		{"No results", testFileEmptyArray, nil, ErrNoResults},
 	}
 	for _, test := range tests {
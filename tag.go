@@ -1,43 +1,259 @@
 package igdb
 
 import (
+	"context"
 	"strconv"
+	"sync"
+
+	"github.com/pkg/errors"
 )
 
+// ErrInvalidTag occurs when a Tag is generated from an object ID too large to
+// fit into a Tag.
+var ErrInvalidTag = errors.New("object ID out of range of a tag")
+
 // Tag is a generated number that represents a specific IGDB object. Tag
 // provides a quick and compact way to do complex filtering on the IGDB API.
 type Tag int
 
-// tagType represents the IGDB Object ID of a particular IGDB object type.
-type tagType int
+// TagType represents the IGDB Object ID of a particular IGDB object type.
+type TagType int
 
-// These tagTypes correspond to their respective IGDB Object Type IDs.
+// These TagTypes correspond to their respective IGDB Object Type IDs.
 //
 // For the list of these IDs and other information,
 // visit: https://igdb.github.io/api/references/tag-numbers/
 const (
-	TagTheme tagType = iota
+	TagTheme TagType = iota
 	TagGenre
 	TagKeyword
 	TagGame
 	TagPerspective
 )
 
+// Bit layout of a Tag: the TagType occupies the bits above tagShift while
+// the object ID occupies the bits below it.
+const (
+	tagShift = 28
+	tagMask  = 1<<tagShift - 1
+)
+
+// String returns the name of the IGDB object type of the TagType.
+func (t TagType) String() string {
+	switch t {
+	case TagTheme:
+		return "Theme"
+	case TagGenre:
+		return "Genre"
+	case TagKeyword:
+		return "Keyword"
+	case TagGame:
+		return "Game"
+	case TagPerspective:
+		return "PlayerPerspective"
+	}
+
+	return "TagType(" + strconv.Itoa(int(t)) + ")"
+}
+
 // GenerateTag uses the ID of an IGDB object type and the ID of an IGDB
 // object to generate a Tag addressed to that object. Negative ID values
-// are considered invalid.
-func GenerateTag(typeID tagType, objectID int) (Tag, error) {
+// are considered invalid. Any type ID is accepted, including type IDs
+// without a TagType constant, but object IDs of 2^28 or more do not fit
+// into a Tag and return ErrInvalidTag.
+func GenerateTag(typeID TagType, objectID int) (Tag, error) {
 	if typeID < 0 || objectID < 0 {
 		return 0, ErrNegativeID
 	}
 
-	tag := int(typeID) << 28
+	if objectID > tagMask {
+		return 0, ErrInvalidTag
+	}
+
+	tag := int(typeID) << tagShift
 	tag |= objectID
 
 	return Tag(tag), nil
 }
 
+// Decode returns the IGDB object type and the IGDB object ID
+// the Tag is addressed to. Decode reverses GenerateTag.
+func (t Tag) Decode() (TagType, int) {
+	return t.Type(), t.ID()
+}
+
+// Type returns the IGDB object type the Tag is addressed to.
+func (t Tag) Type() TagType {
+	return TagType(int(t) >> tagShift)
+}
+
+// ID returns the ID of the IGDB object the Tag is addressed to.
+func (t Tag) ID() int {
+	return int(t) & tagMask
+}
+
 // String returns the provided Tag as a string.
 func (t Tag) String() string {
 	return strconv.Itoa(int(t))
 }
+
+// TagGroups holds the object IDs of a list of Tags grouped by their
+// IGDB object type. Tags of an unknown type are kept in Unknown.
+type TagGroups struct {
+	Themes       []int
+	Genres       []int
+	Keywords     []int
+	Games        []int
+	Perspectives []int
+	Unknown      []Tag
+}
+
+// GroupTags decodes the provided Tags and groups their object IDs
+// by IGDB object type, preserving the order of the Tags.
+func GroupTags(tags []Tag) TagGroups {
+	var g TagGroups
+	for _, t := range tags {
+		typ, id := t.Decode()
+		switch typ {
+		case TagTheme:
+			g.Themes = append(g.Themes, id)
+		case TagGenre:
+			g.Genres = append(g.Genres, id)
+		case TagKeyword:
+			g.Keywords = append(g.Keywords, id)
+		case TagGame:
+			g.Games = append(g.Games, id)
+		case TagPerspective:
+			g.Perspectives = append(g.Perspectives, id)
+		default:
+			g.Unknown = append(g.Unknown, t)
+		}
+	}
+
+	return g
+}
+
+// TaggedObjects holds the IGDB objects a list of Tags is addressed to,
+// grouped by IGDB object type.
+type TaggedObjects struct {
+	Themes       []*Theme
+	Genres       []*Genre
+	Keywords     []*Keyword
+	Games        []*Game
+	Perspectives []*PlayerPerspective
+}
+
+// ResolveTags retrieves the IGDB objects the provided Tags are addressed to.
+// The objects of each type are retrieved concurrently with a single List call,
+// split into batches as needed. Objects are listed in the order of the Tags
+// and Tags that do not match any object or are of an unknown type are ignored.
+// Provide the SetFields functional option if you need to specify which fields
+// to retrieve; by default every field is retrieved.
+func (c *Client) ResolveTags(ctx context.Context, tags []Tag, opts ...Option) (*TaggedObjects, error) {
+	if len(opts) == 0 {
+		opts = []Option{SetFields("*")}
+	}
+
+	g := GroupTags(tags)
+	res := &TaggedObjects{}
+
+	lookups := []func() error{
+		func() error {
+			return resolve(c.Themes.Loader(ctx, opts...), g.Themes, func(v *Theme) { res.Themes = append(res.Themes, v) })
+		},
+		func() error {
+			return resolve(c.Genres.Loader(ctx, opts...), g.Genres, func(v *Genre) { res.Genres = append(res.Genres, v) })
+		},
+		func() error {
+			return resolve(c.Keywords.Loader(ctx, opts...), g.Keywords, func(v *Keyword) { res.Keywords = append(res.Keywords, v) })
+		},
+		func() error {
+			return resolve(c.Games.Loader(ctx, opts...), g.Games, func(v *Game) { res.Games = append(res.Games, v) })
+		},
+		func() error {
+			return resolve(c.PlayerPerspectives.Loader(ctx, opts...), g.Perspectives, func(v *PlayerPerspective) {
+				res.Perspectives = append(res.Perspectives, v)
+			})
+		},
+	}
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs error
+	)
+
+	for _, fn := range lookups {
+		wg.Add(1)
+		go func(fn func() error) {
+			defer wg.Done()
+
+			if err := fn(); err != nil {
+				mu.Lock()
+				if errs == nil {
+					errs = err
+				}
+				mu.Unlock()
+			}
+		}(fn)
+	}
+
+	wg.Wait()
+	if errs != nil {
+		return nil, errors.Wrap(errs, "cannot resolve tags")
+	}
+
+	return res, nil
+}
+
+// resolve retrieves the objects identified by the provided IDs with the
+// provided Loader and passes each object found to the provided assign
+// function, in the order of the IDs.
+func resolve[T any](l *Loader[T], ids []int, assign func(v *T)) error {
+	if len(ids) < 1 {
+		return nil
+	}
+
+	found, err := l.loadMap(ids)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		if v, ok := found[id]; ok {
+			assign(v)
+		}
+	}
+
+	return nil
+}
+
+// TaggedAny returns a Filter matching results tagged with
+// at least one of the provided Tags.
+func TaggedAny(tags ...Tag) Filter {
+	return ContainsAny("tags", tagOperands(tags)...)
+}
+
+// TaggedAll returns a Filter matching results tagged with
+// every one of the provided Tags.
+func TaggedAll(tags ...Tag) Filter {
+	return ContainsAll("tags", tagOperands(tags)...)
+}
+
+// SetTagged is a functional option used to filter the results from an API
+// call to those tagged with at least one of the provided Tags. For example,
+// the Games tagged with either a particular Genre or a particular Theme.
+// Use TaggedAny and TaggedAll with SetWhere for more complex filters.
+func SetTagged(tags ...Tag) Option {
+	return SetWhere(TaggedAny(tags...))
+}
+
+// tagOperands returns the provided Tags as Filter operands.
+func tagOperands(tags []Tag) []interface{} {
+	vals := make([]interface{}, len(tags))
+	for i, t := range tags {
+		vals[i] = t
+	}
+
+	return vals
+}
@@ -1,14 +1,18 @@
 package igdb
 
 import (
+	"context"
+	"net/http"
 	"reflect"
 	"testing"
+
+	"github.com/pkg/errors"
 )
 
 func TestGenerateTag(t *testing.T) {
 	var tagTests = []struct {
 		Name     string
-		TypeID   tagType
+		TypeID   TagType
 		ObjectID int
 		ExpTag   Tag
 		ExpErr   error
@@ -16,6 +20,8 @@ func TestGenerateTag(t *testing.T) {
 		{"ObjectID at zero", TagTheme, 0, 0, nil},
 		{"ObjectID within range", TagGenre, 5, 268435461, nil},
 		{"OjectID below range", TagKeyword, -1234, 0, ErrNegativeID},
+		{"ObjectID above range", TagGame, 1 << 28, 0, ErrInvalidTag},
+		{"Unknown TypeID", 5, 1, 1342177281, nil},
 	}
 
 	for _, tt := range tagTests {
@@ -55,3 +61,129 @@ func TestTagString(t *testing.T) {
 		})
 	}
 }
+
+func TestTagDecode(t *testing.T) {
+	var tagTests = []struct {
+		Name     string
+		Tag      Tag
+		ExpType  TagType
+		ExpID    int
+		ExpTypeS string
+	}{
+		{"Zero Tag", 0, TagTheme, 0, "Theme"},
+		{"Genre Tag", 268435461, TagGenre, 5, "Genre"},
+		{"Keyword Tag", 2<<28 | 1234, TagKeyword, 1234, "Keyword"},
+		{"Game Tag", 3<<28 | 1942, TagGame, 1942, "Game"},
+		{"Perspective Tag", 4<<28 | 7, TagPerspective, 7, "PlayerPerspective"},
+		{"Unknown Tag", 9<<28 | 3, 9, 3, "TagType(9)"},
+	}
+
+	for _, tt := range tagTests {
+		t.Run(tt.Name, func(t *testing.T) {
+			typ, id := tt.Tag.Decode()
+			if typ != tt.ExpType {
+				t.Errorf("Expected type %v, got %v", tt.ExpType, typ)
+			}
+
+			if id != tt.ExpID {
+				t.Errorf("Expected ID %d, got %d", tt.ExpID, id)
+			}
+
+			if typ.String() != tt.ExpTypeS {
+				t.Errorf("Expected type string '%s', got '%s'", tt.ExpTypeS, typ.String())
+			}
+
+			if tt.ExpType > TagPerspective {
+				return
+			}
+
+			tag, err := GenerateTag(typ, id)
+			if err != nil || tag != tt.Tag {
+				t.Errorf("Expected tag %d to round trip, got %d, %v", tt.Tag, tag, err)
+			}
+		})
+	}
+}
+
+func TestGroupTags(t *testing.T) {
+	tags := []Tag{1<<28 | 5, 0<<28 | 17, 1<<28 | 12, 3<<28 | 1942, 2<<28 | 8, 4<<28 | 2, 7<<28 | 1}
+	exp := TagGroups{
+		Themes:       []int{17},
+		Genres:       []int{5, 12},
+		Keywords:     []int{8},
+		Games:        []int{1942},
+		Perspectives: []int{2},
+		Unknown:      []Tag{7<<28 | 1},
+	}
+
+	act := GroupTags(tags)
+	if !reflect.DeepEqual(act, exp) {
+		t.Fatalf("Expected groups %+v, got %+v", exp, act)
+	}
+
+	if act := GroupTags(nil); !reflect.DeepEqual(act, TagGroups{}) {
+		t.Fatalf("Expected empty groups, got %+v", act)
+	}
+}
+
+func TestSetTagged(t *testing.T) {
+	genre, _ := GenerateTag(TagGenre, 5)
+	theme, _ := GenerateTag(TagTheme, 17)
+
+	tests := []struct {
+		name    string
+		opts    []Option
+		want    string
+		wantErr error
+	}{
+		{"Single tag", []Option{SetTagged(genre)}, "where tags = (268435461); ", nil},
+		{"Any tag", []Option{SetTagged(genre, theme)}, "where tags = (268435461,17); ", nil},
+		{"All tags", []Option{SetWhere(TaggedAll(genre, theme))}, "where tags = [268435461,17]; ", nil},
+		{"No tags", []Option{SetTagged()}, "", ErrEmptyFilterVals},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			qry, err := renderQuery(test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Fatalf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if qry != test.want {
+				t.Errorf("got: <%v>, want: <%v>", qry, test.want)
+			}
+		})
+	}
+}
+
+func TestClient_ResolveTags(t *testing.T) {
+	calls := make(map[string][][]int)
+	ts, c := startHydrateServer(http.StatusOK, calls)
+	defer ts.Close()
+
+	tags := []Tag{1<<28 | 22, 3<<28 | 2, 1<<28 | 20, 1<<28 | 99, 9<<28 | 1}
+
+	obj, err := c.ResolveTags(context.Background(), tags)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(obj.Genres) != 2 || obj.Genres[0].ID != 22 || obj.Genres[1].ID != 20 {
+		t.Errorf("Expected Genres 22 and 20, got %v", obj.Genres)
+	}
+
+	if len(obj.Games) != 1 || obj.Games[0].Name != "second" {
+		t.Errorf("Expected Game 2, got %v", obj.Games)
+	}
+
+	if len(calls) != 2 {
+		t.Errorf("Expected calls to 2 endpoints, got %v", calls)
+	}
+
+	ts, c = startHydrateServer(http.StatusBadRequest, make(map[string][][]int))
+	defer ts.Close()
+
+	_, err = c.ResolveTags(context.Background(), tags)
+	if errors.Cause(err) != ErrBadRequest {
+		t.Fatalf("Expected error '%v', got '%v'", ErrBadRequest, errors.Cause(err))
+	}
+}
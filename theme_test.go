@@ -37,7 +37,7 @@ func TestThemeService_Get(t *testing.T) {
 	}{
 		{"Valid response", testThemeGet, 38, []Option{SetFields("name")}, init[0], nil},
 		{"Invalid ID", testFileEmpty, -1, nil, nil, ErrNegativeID},
-		{"Empty response", testFileEmpty, 38, nil, nil, errInvalidJSON},
+		{"Empty response", testFileEmpty, 38, nil, nil, ErrInvalidJSON},
 		{"Invalid option", testFileEmpty, 38, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
 		{"No results", testFileEmptyArray, 0, nil, nil, ErrNoResults},
 	}
@@ -84,7 +84,7 @@ func TestThemeService_List(t *testing.T) {
 		{"Valid response", testThemeList, []int{19, 39, 32, 1, 18}, []Option{SetLimit(5)}, init, nil},
 		{"Zero IDs", testFileEmpty, nil, nil, nil, ErrEmptyIDs},
 		{"Invalid ID", testFileEmpty, []int{-500}, nil, nil, ErrNegativeID},
-		{"Empty response", testFileEmpty, []int{19, 39, 32, 1, 18}, nil, nil, errInvalidJSON},
+		{"Empty response", testFileEmpty, []int{19, 39, 32, 1, 18}, nil, nil, ErrInvalidJSON},
 		{"Invalid option", testFileEmpty, []int{19, 39, 32, 1, 18}, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
 		{"No results", testFileEmptyArray, []int{0, 9999999}, nil, nil, ErrNoResults},
 	}
@@ -128,7 +128,7 @@ func TestThemeService_Index(t *testing.T) {
 		wantErr    error
 	}{
 		{"Valid response", testThemeList, []Option{SetLimit(5)}, init, nil},
-		{"Empty response", testFileEmpty, nil, nil, errInvalidJSON},
+		{"Empty response", testFileEmpty, nil, nil, ErrInvalidJSON},
 		{"Invalid option", testFileEmpty, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
 		{"No results", testFileEmptyArray, nil, nil, ErrNoResults},
 	}
@@ -174,7 +174,7 @@ func TestThemeService_Search(t *testing.T) {
 	}{
 		{"Valid response", testThemeSearch, "fiction", []Option{SetLimit(50)}, init, nil},
 		{"Empty query", testFileEmpty, "", []Option{SetLimit(50)}, nil, ErrEmptyQry},
-		{"Empty response", testFileEmpty, "fiction", nil, nil, errInvalidJSON},
+		{"Empty response", testFileEmpty, "fiction", nil, nil, ErrInvalidJSON},
 		{"Invalid option", testFileEmpty, "fiction", []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
 		{"No results", testFileEmptyArray, "non-existent entry", nil, nil, ErrNoResults},
 	}
@@ -207,7 +207,7 @@ func TestThemeService_Count(t *testing.T) {
 		wantErr   error
 	}{
 		{"Happy path", `{"count": 100}`, []Option{SetFilter("popularity", OpGreaterThan, "75")}, 100, nil},
-		{"Empty response", "", nil, 0, errInvalidJSON},
+		{"Empty response", "", nil, 0, ErrInvalidJSON},
 		{"Invalid option", "", []Option{SetLimit(-99999)}, 0, ErrOutOfRange},
 		{"No results", "[]", nil, 0, ErrNoResults},
 	}
@@ -239,7 +239,7 @@ func TestThemeService_Fields(t *testing.T) {
 	}{
 		{"Happy path", `["name", "slug", "url"]`, []string{"url", "slug", "name"}, nil},
 		{"Asterisk", `["*"]`, []string{"*"}, nil},
-		{"Empty response", "", nil, errInvalidJSON},
+		{"Empty response", "", nil, ErrInvalidJSON},
 		{"No results", "[]", nil, nil},
 	}
 
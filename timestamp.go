@@ -0,0 +1,87 @@
+package igdb
+
+import (
+	"bytes"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Timestamp is a point in time the IGDB represents as a Unix timestamp in
+// seconds, such as the time an object was created or a game was released.
+// The zero Timestamp is unset, which is distinct from a Timestamp set to the
+// Unix epoch. Timestamps decoded from the IGDB are in UTC.
+type Timestamp struct {
+	time time.Time
+	set  bool
+}
+
+// NewTimestamp returns a Timestamp set to the provided time,
+// truncated to the second.
+func NewTimestamp(t time.Time) Timestamp {
+	return Timestamp{time: time.Unix(t.Unix(), 0).UTC(), set: true}
+}
+
+// IsSet returns true if the Timestamp was set, whether by the IGDB or by
+// NewTimestamp. Fields the IGDB omits or sets to null are not set.
+func (t Timestamp) IsSet() bool {
+	return t.set
+}
+
+// Time returns the Timestamp as a time.Time. If the Timestamp is
+// not set, the zero time.Time is returned.
+func (t Timestamp) Time() time.Time {
+	return t.time
+}
+
+// Unix returns the Timestamp as the number of seconds elapsed
+// since the Unix epoch. If the Timestamp is not set, 0 is returned.
+func (t Timestamp) Unix() int64 {
+	if !t.set {
+		return 0
+	}
+
+	return t.time.Unix()
+}
+
+// String returns the Timestamp formatted as RFC 3339, or "unset"
+// if the Timestamp is not set.
+func (t Timestamp) String() string {
+	if !t.set {
+		return "unset"
+	}
+
+	return t.time.Format(time.RFC3339)
+}
+
+// MarshalJSON encodes the Timestamp as a Unix timestamp in seconds,
+// or as null if the Timestamp is not set.
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	if !t.set {
+		return []byte("null"), nil
+	}
+
+	return []byte(strconv.FormatInt(t.time.Unix(), 10)), nil
+}
+
+// UnmarshalJSON decodes a Unix timestamp in seconds into the Timestamp.
+// A null value leaves the Timestamp unset.
+func (t *Timestamp) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(b, []byte("null")) {
+		*t = Timestamp{}
+		return nil
+	}
+
+	sec, err := strconv.ParseInt(string(b), 10, 64)
+	if err != nil {
+		f, ferr := strconv.ParseFloat(string(b), 64)
+		if ferr != nil {
+			return errors.Wrapf(ErrInvalidJSON, "cannot decode %s as a Timestamp", b)
+		}
+		sec = int64(f)
+	}
+
+	*t = Timestamp{time: time.Unix(sec, 0).UTC(), set: true}
+	return nil
+}
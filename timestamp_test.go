@@ -0,0 +1,92 @@
+package igdb
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+func TestTimestamp_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    string
+		wantSet bool
+		want    time.Time
+		wantErr error
+	}{
+		{"Seconds", `1577836800`, true, time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), nil},
+		{"Epoch", `0`, true, time.Unix(0, 0).UTC(), nil},
+		{"Before epoch", `-86400`, true, time.Date(1969, 12, 31, 0, 0, 0, 0, time.UTC), nil},
+		{"Float", `1577836800.0`, true, time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), nil},
+		{"Null", `null`, false, time.Time{}, nil},
+		{"String", `"2020-01-01"`, false, time.Time{}, ErrInvalidJSON},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var ts Timestamp
+			err := json.Unmarshal([]byte(test.json), &ts)
+			if errors.Cause(err) != test.wantErr {
+				t.Fatalf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if ts.IsSet() != test.wantSet {
+				t.Errorf("got: <%v>, want: <%v>", ts.IsSet(), test.wantSet)
+			}
+
+			if !ts.Time().Equal(test.want) {
+				t.Errorf("got: <%v>, want: <%v>", ts.Time(), test.want)
+			}
+		})
+	}
+}
+
+func TestTimestamp_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		ts   Timestamp
+		want string
+	}{
+		{"Set", NewTimestamp(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)), `1577836800`},
+		{"Truncated", NewTimestamp(time.Unix(100, 999)), `100`},
+		{"Epoch", NewTimestamp(time.Unix(0, 0)), `0`},
+		{"Unset", Timestamp{}, `null`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			b, err := json.Marshal(test.ts)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if string(b) != test.want {
+				t.Errorf("got: <%v>, want: <%v>", string(b), test.want)
+			}
+
+			var ts Timestamp
+			if err := json.Unmarshal(b, &ts); err != nil {
+				t.Fatal(err)
+			}
+
+			if ts != test.ts {
+				t.Errorf("got: <%v>, want: <%v>", ts, test.ts)
+			}
+		})
+	}
+}
+
+func TestTimestamp_Absent(t *testing.T) {
+	var g Game
+	if err := json.Unmarshal([]byte(`{"id": 1, "created_at": 1500000000}`), &g); err != nil {
+		t.Fatal(err)
+	}
+
+	if !g.CreatedAt.IsSet() || g.CreatedAt.Unix() != 1500000000 {
+		t.Errorf("got: <%v>, want: <%v>", g.CreatedAt, 1500000000)
+	}
+
+	if g.FirstReleaseDate.IsSet() || g.FirstReleaseDate.Unix() != 0 || g.FirstReleaseDate.String() != "unset" {
+		t.Errorf("got: <%v>, want: unset Timestamp", g.FirstReleaseDate)
+	}
+}